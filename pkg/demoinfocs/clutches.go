@@ -0,0 +1,158 @@
+package demoinfocs
+
+import (
+	common "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// bindClutches detects clutch situations, see Round.Clutches, events.ClutchStart and events.ClutchEnd.
+// Must be registered after bindRounds() since it relies on the current round being up-to-date.
+func (p *parser) bindClutches() {
+	gs := p.gameState
+
+	// Players die, disconnect and switch teams during the frame, the alive players are only counted once at the end of it.
+	// Delayed handlers may dispatch further Kill events (e.g. a delayed player_death), so the check moves itself
+	// behind any handler that was queued after it. That way it runs exactly once per frame, after all of them.
+	var (
+		checkPending bool
+		checkIndex   int // Index of the pending check in delayedEventHandlers
+		check        func()
+	)
+
+	check = func() {
+		if last := len(p.delayedEventHandlers) - 1; last > checkIndex {
+			checkIndex = last + 1
+			p.delayedEventHandlers = append(p.delayedEventHandlers, check)
+
+			return
+		}
+
+		checkPending = false
+
+		p.checkClutchStart()
+	}
+
+	scheduleCheck := func() {
+		if checkPending {
+			return
+		}
+
+		checkPending = true
+		checkIndex = len(p.delayedEventHandlers)
+		p.delayedEventHandlers = append(p.delayedEventHandlers, check)
+	}
+
+	p.eventDispatcher.RegisterHandler(func(events.RoundFreezetimeEnd) {
+		// a team may be short-handed from the start
+		scheduleCheck()
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.Kill) {
+		if round := gs.CurrentRound(); round != nil && e.Killer != nil && e.Victim != nil {
+			for _, c := range round.Clutches {
+				isClutcher := e.Killer == c.Player || e.Killer.Controller() == c.Player.Controller()
+				if !c.IsOver() && isClutcher && e.Victim.Team != c.Team {
+					c.Kills++
+				}
+			}
+		}
+
+		scheduleCheck()
+	})
+
+	p.eventDispatcher.RegisterHandler(func(events.PlayerDisconnected) {
+		scheduleCheck()
+	})
+
+	p.eventDispatcher.RegisterHandler(func(events.PlayerTeamChange) {
+		scheduleCheck()
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.RoundEnd) {
+		round := gs.CurrentRound()
+		if round == nil {
+			return
+		}
+
+		var ended []*common.Clutch
+
+		for _, c := range round.Clutches {
+			if c.IsOver() {
+				continue
+			}
+
+			c.EndTick = gs.ingameTick
+			c.Won = e.Winner == c.Team
+			c.Saved = !c.Won && c.Player.IsConnected && c.Player.IsAlive()
+
+			ended = append(ended, c)
+		}
+
+		if len(ended) == 0 {
+			return
+		}
+
+		p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+			for _, c := range ended {
+				p.gameEventHandler.dispatch(events.ClutchEnd{
+					Player: c.Player,
+					Clutch: c,
+				})
+			}
+		})
+	})
+}
+
+// checkClutchStart starts a clutch for each team that has only one player left alive while enemies are still alive.
+func (p *parser) checkClutchStart() {
+	gs := p.gameState
+
+	round := gs.CurrentRound()
+	if round == nil || round.HasEnded() || round.FreezetimeEndTick == -1 {
+		return
+	}
+
+	ctAlive := gs.aliveTeamMembers(common.TeamCounterTerrorists)
+	tAlive := gs.aliveTeamMembers(common.TeamTerrorists)
+
+	for _, side := range []struct {
+		team      common.Team
+		alive     []*common.Player
+		opponents int
+	}{
+		{common.TeamCounterTerrorists, ctAlive, len(tAlive)},
+		{common.TeamTerrorists, tAlive, len(ctAlive)},
+	} {
+		if len(side.alive) != 1 || side.opponents == 0 || round.Clutch(side.team) != nil {
+			continue
+		}
+
+		clutch := &common.Clutch{
+			Player:    side.alive[0],
+			Team:      side.team,
+			Opponents: side.opponents,
+			StartTick: gs.ingameTick,
+			EndTick:   -1,
+		}
+
+		round.Clutches = append(round.Clutches, clutch)
+
+		p.gameEventHandler.dispatch(events.ClutchStart{
+			Player: clutch.Player,
+			Clutch: clutch,
+		})
+	}
+}
+
+// aliveTeamMembers returns the connected players of the given team that are alive.
+func (gs *gameState) aliveTeamMembers(team common.Team) []*common.Player {
+	var alive []*common.Player
+
+	for _, pl := range gs.Participants().TeamMembers(team) {
+		if pl.IsConnected && pl.IsAlive() {
+			alive = append(alive, pl)
+		}
+	}
+
+	return alive
+}
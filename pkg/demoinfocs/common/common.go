@@ -4,7 +4,6 @@ package common
 import (
 	"fmt"
 	"math"
-	"math/rand"
 	"strconv"
 	"strings"
 	"time"
@@ -73,12 +72,23 @@ type GrenadeProjectile struct {
 	InitialPosition r3.Vector
 	InitialVelocity r3.Vector
 	Bouces          int
+	Trajectory      []TrajectoryEntry // List of all known locations of the grenade up to the current point
 
-	// uniqueID is used to distinguish different grenades (which potentially have the same, reused entityID) from each other.
+	// creationTick is used to distinguish different grenades (which potentially have the same, reused entityID) from each other.
+	creationTick     int
 	uniqueID         int64
 	demoInfoProvider demoInfoProvider
 }
 
+// TrajectoryEntry represents the location of a grenade's trajectory at a specific point in time.
+type TrajectoryEntry struct {
+	Position r3.Vector
+	Tick     int
+	FrameID  int
+	Time     time.Duration
+	IsBounce bool // True if the grenade bounced off something at this location
+}
+
 // Position returns the current position of the grenade projectile in world coordinates.
 func (g *GrenadeProjectile) Position() r3.Vector {
 	return g.Entity.Position()
@@ -106,12 +116,27 @@ func (g *GrenadeProjectile) DemoInfo() demoInfoProvider {
 }
 
 // UniqueID returns the unique id of the grenade.
-// The unique id is a random int generated internally by this library and can be used to differentiate
-// grenades from each other. This is needed because demo-files reuse entity ids.
+// The unique id can be used to differentiate grenades from each other. This is needed because demo-files reuse entity ids.
+// It's derived from the entity and the tick at which the grenade was thrown, so it's stable across parsing runs.
+// See UniqueIDComponents() for details.
+//
+// The id is computed when the projectile is created and changes only once, when the parser attaches the entity
+// (see AttachEntity()), which happens right after its creation.
 func (g *GrenadeProjectile) UniqueID() int64 {
 	return g.uniqueID
 }
 
+// AttachEntity sets the entity of the projectile.
+// If the projectile didn't have an entity yet, UniqueID() is derived from the new entity from now on.
+// Intended for internal use only.
+func (g *GrenadeProjectile) AttachEntity(entity st.Entity) {
+	if g.Entity == nil && entity != nil {
+		g.uniqueID = entityUniqueID(entity, 0, g.creationTick)
+	}
+
+	g.Entity = entity
+}
+
 func (g *GrenadeProjectile) Team() Team {
 	if g.Owner == nil {
 		return Team(getUInt64(g.Entity, "m_iTeamNum"))
@@ -119,16 +144,29 @@ func (g *GrenadeProjectile) Team() Team {
 	return g.Owner.Team
 }
 
-// NewGrenadeProjectile creates a grenade projectile and sets the Unique-ID.
+// NewGrenadeProjectile creates a grenade projectile and records the creation tick used for the Unique-ID.
 //
 // Intended for internal use only.
 func NewGrenadeProjectile(demoInfoProvider demoInfoProvider) *GrenadeProjectile {
+	creationTick := demoInfoProvider.IngameTick()
+
 	return &GrenadeProjectile{
-		uniqueID:         rand.Int63(),
+		creationTick:     creationTick,
+		uniqueID:         newUniqueID(nil, demoInfoProvider, creationTick),
 		demoInfoProvider: demoInfoProvider,
-	} //nolint:gosec
+	}
 }
 
+// Bombsite identifies a bomb site.
+type Bombsite rune
+
+// Bombsite identifiers
+const (
+	BomsiteUnknown Bombsite = 0
+	BombsiteA      Bombsite = 'A'
+	BombsiteB      Bombsite = 'B'
+)
+
 // Bomb tracks the bomb's position, and the player carrying it, if any.
 type Bomb struct {
 	// Intended for internal use only. Use Position() instead.
@@ -138,12 +176,29 @@ type Bomb struct {
 	Planted              bool
 	Defused              bool
 	InDefuse             bool
+	PlantTick            int // Tick at which the bomb was planted, -1 if it isn't planted
+
+	// Intended for internal use only.
+	// The CPlantedC4 entity while the bomb is planted, nil otherwise.
+	PlantedEntity st.Entity
+	// Intended for internal use only.
+	// Duration of the bomb timer (GameRules().BombTime()), used if the planted entity doesn't contain the explosion time.
+	Timer time.Duration
 
 	demoInfoProvider demoInfoProvider
 }
 
+// Defuse durations with and without a defuse kit.
+const (
+	DefuseDuration        = 10 * time.Second
+	DefuseDurationWithKit = 5 * time.Second
+)
+
 func NewBomb(demoInfoProvider demoInfoProvider) Bomb {
-	return Bomb{demoInfoProvider: demoInfoProvider}
+	return Bomb{
+		PlantTick:        -1,
+		demoInfoProvider: demoInfoProvider,
+	}
 }
 
 func (g *Bomb) DemoInfo() demoInfoProvider {
@@ -165,6 +220,92 @@ func (b *Bomb) Reset() {
 	b.Planted = false
 	b.Defused = false
 	b.InDefuse = false
+	b.PlantTick = -1
+	b.PlantedEntity = nil
+}
+
+// TimeUntilExplosion returns the time left until the planted bomb explodes.
+// Based on m_flC4Blow or GameRules().BombTime() if it's not available.
+// Returns 0 if the bomb isn't planted, has been defused or has already exploded.
+func (b *Bomb) TimeUntilExplosion() time.Duration {
+	if !b.Planted || b.Defused {
+		return 0
+	}
+
+	var remaining time.Duration
+
+	if blow, ok := b.plantedEntityTime("m_flC4Blow"); ok {
+		remaining = blow - b.currentTime()
+	} else if b.Timer > 0 && b.PlantTick >= 0 {
+		remaining = b.Timer - b.ticksToDuration(b.demoInfoProvider.IngameTick()-b.PlantTick)
+	}
+
+	return max(remaining, 0)
+}
+
+// DefuseTimeRemaining returns the time left until the bomb is defused by the current defuser.
+// Based on m_flDefuseCountDown.
+// Returns 0 if nobody is defusing the bomb.
+func (b *Bomb) DefuseTimeRemaining() time.Duration {
+	if !b.InDefuse {
+		return 0
+	}
+
+	countDown, ok := b.plantedEntityTime("m_flDefuseCountDown")
+	if !ok {
+		return 0
+	}
+
+	return max(countDown-b.currentTime(), 0)
+}
+
+// CanBeDefusedInTime returns true if the planted bomb can still be defused before it explodes.
+// If somebody is currently defusing the bomb DefuseTimeRemaining() is used,
+// otherwise the duration of a full defuse with or without kit (DefuseDuration / DefuseDurationWithKit).
+func (b *Bomb) CanBeDefusedInTime(withKit bool) bool {
+	if !b.Planted || b.Defused {
+		return false
+	}
+
+	timeLeft := b.TimeUntilExplosion()
+	if timeLeft <= 0 {
+		return false
+	}
+
+	if b.InDefuse {
+		if remaining := b.DefuseTimeRemaining(); remaining > 0 {
+			return remaining <= timeLeft
+		}
+	}
+
+	if withKit {
+		return DefuseDurationWithKit <= timeLeft
+	}
+
+	return DefuseDuration <= timeLeft
+}
+
+// plantedEntityTime returns a game-time prop of the planted bomb entity.
+func (b *Bomb) plantedEntityTime(propName string) (time.Duration, bool) {
+	if b.PlantedEntity == nil {
+		return 0, false
+	}
+
+	val, ok := b.PlantedEntity.PropertyValue(propName)
+	if !ok || val.Any == nil || val.Float() <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(float64(val.Float()) * float64(time.Second)), true
+}
+
+// currentTime returns the current game-time (what m_flC4Blow etc. are relative to).
+func (b *Bomb) currentTime() time.Duration {
+	return b.ticksToDuration(b.demoInfoProvider.IngameTick())
+}
+
+func (b *Bomb) ticksToDuration(ticks int) time.Duration {
+	return time.Duration(float64(ticks) / tickRateOf(b.demoInfoProvider) * float64(time.Second))
 }
 
 // TeamState contains a team's ID, score, clan name & country flag.
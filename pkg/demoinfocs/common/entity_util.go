@@ -1,6 +1,10 @@
 package common
 
-import st "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
+import (
+	"github.com/golang/geo/r3"
+
+	st "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
+)
 
 func getInt(entity st.Entity, propName string) int {
 	if entity == nil {
@@ -14,6 +18,27 @@ func getInt(entity st.Entity, propName string) int {
 	return val.Int()
 }
 
+// getUInt32Any returns the value of an unsigned integer property that may be decoded as uint32 or uint64.
+func getUInt32Any(entity st.Entity, propName string) (uint32, bool) {
+	if entity == nil {
+		return 0, false
+	}
+
+	val, ok := entity.PropertyValue(propName)
+	if !ok {
+		return 0, false
+	}
+
+	switch v := val.Any.(type) {
+	case uint32:
+		return v, true
+	case uint64:
+		return uint32(v), true
+	default:
+		return 0, false
+	}
+}
+
 func getUInt64(entity st.Entity, propName string) uint64 {
 	if entity == nil {
 		return 0
@@ -58,3 +83,38 @@ func getBool(entity st.Entity, propName string) bool {
 
 	return val.BoolVal()
 }
+
+// getVector returns the value of a vector prop.
+// Supports both vector props and network vectors that are split into m_vecX, m_vecY & m_vecZ.
+func getVector(entity st.Entity, propName string) (r3.Vector, bool) {
+	if entity == nil {
+		return r3.Vector{}, false
+	}
+
+	if val, ok := entity.PropertyValue(propName); ok && val.Any != nil {
+		if fs, ok := val.Any.([]float32); ok && len(fs) >= 3 {
+			return r3.Vector{X: float64(fs[0]), Y: float64(fs[1]), Z: float64(fs[2])}, true
+		}
+	}
+
+	var (
+		res        r3.Vector
+		components = [...]*float64{&res.X, &res.Y, &res.Z}
+	)
+
+	for i, suffix := range [...]string{".m_vecX", ".m_vecY", ".m_vecZ"} {
+		val, ok := entity.PropertyValue(propName + suffix)
+		if !ok || val.Any == nil {
+			return r3.Vector{}, false
+		}
+
+		f, ok := val.Any.(float32)
+		if !ok {
+			return r3.Vector{}, false
+		}
+
+		*components[i] = float64(f)
+	}
+
+	return res, true
+}
@@ -2,7 +2,6 @@ package common
 
 import (
 	"math"
-	"math/rand"
 	"strings"
 
 	"github.com/oklog/ulid/v2"
@@ -343,6 +342,65 @@ var EquipmentMaxSpeed = map[EquipmentType]int{
 	EqG3SG1:  215,
 }
 
+// EquipmentCost maps equipment types to their price in the buy menu.
+// Prices are the ones of CS2 at the time of writing and may change with game updates.
+// Only items that are detected by events.ItemPurchase are included, i.e. neither armor and defuse kits
+// nor equipment that can't be bought.
+var EquipmentCost = map[EquipmentType]int{
+	// Pistols
+	EqP2000:        200,
+	EqGlock:        200,
+	EqUSP:          200,
+	EqP250:         300,
+	EqDualBerettas: 300,
+	EqFiveSeven:    500,
+	EqTec9:         500,
+	EqCZ:           500,
+	EqRevolver:     600,
+	EqDeagle:       700,
+
+	// SMGs
+	EqMac10: 1050,
+	EqUMP:   1200,
+	EqMP9:   1250,
+	EqBizon: 1400,
+	EqMP7:   1500,
+	EqMP5:   1500,
+	EqP90:   2350,
+
+	// Heavy
+	EqNova:     1050,
+	EqSawedOff: 1100,
+	EqMag7:     1300,
+	EqNegev:    1700,
+	EqXM1014:   2000,
+	EqM249:     5200,
+
+	// Rifles
+	EqSSG08:  1700,
+	EqGalil:  1800,
+	EqFamas:  2050,
+	EqAK47:   2700,
+	EqM4A1:   2900,
+	EqSG553:  3000,
+	EqM4A4:   3100,
+	EqAUG:    3300,
+	EqAWP:    4750,
+	EqScar20: 5000,
+	EqG3SG1:  5000,
+
+	// Equipment
+	EqZeus: 200,
+
+	// Grenades
+	EqDecoy:      50,
+	EqFlash:      200,
+	EqSmoke:      300,
+	EqHE:         300,
+	EqMolotov:    400,
+	EqIncendiary: 500,
+}
+
 // ZoomLevel contains how far a player is zoomed in.
 type ZoomLevel int
 
@@ -363,7 +421,8 @@ type Equipment struct {
 	Skin     *Skin
 	State    int
 
-	uniqueID         int64 // Deprecated, use uniqueID2, see UniqueID() for why
+	creationTick     int // Used for UniqueID()
+	uniqueID         int64
 	uniqueID2        ulid.ULID
 	demoInfoProvider demoInfoProvider
 }
@@ -380,19 +439,46 @@ func (e *Equipment) Class() EquipmentClass {
 	return e.Type.Class()
 }
 
-// UniqueID returns a randomly generated unique id of the equipment element.
-// The unique id is a random int generated internally by this library and can be used to differentiate
-// equipment from each other. This is needed because demo-files reuse entity ids.
-// Deprecated: Use UniqueID2 instead. Since UniqueID is randomly generated, duplicate IDs are possible.
-// See the birthday problem for why repeatedly generating random 64 bit integers is likely to produce a collision.
+// UniqueID returns a unique id of the equipment element.
+// The unique id can be used to differentiate equipment from each other. This is needed because demo-files reuse entity ids.
+// It's derived from the entity and the tick at which the equipment was created, so it's stable across parsing runs.
+// See UniqueIDComponents() for details.
+//
+// The id is computed once when the equipment is created. The only exception is equipment that was created
+// without an entity (e.g. weapons that show up in a player's inventory before their entity is created):
+// its id changes once, when the parser attaches the entity (see AttachEntity()). Later changes of Entity don't affect it.
 func (e *Equipment) UniqueID() int64 {
 	return e.uniqueID
 }
 
+// AttachEntity sets the entity of the equipment.
+// If the equipment didn't have an entity yet, UniqueID() is derived from the new entity from now on.
+// Intended for internal use only.
+func (e *Equipment) AttachEntity(entity st.Entity) {
+	if e.Entity == nil && entity != nil {
+		e.uniqueID = entityUniqueID(entity, 0, e.creationTick)
+	}
+
+	e.Entity = entity
+}
+
+// OriginalOwnerSteamID64 returns the SteamID64 of the player that bought the equipment or received it at spawn.
+// Returns 0 if it's unknown, e.g. for equipment of bots or if the demo doesn't contain the information.
+func (e *Equipment) OriginalOwnerSteamID64() uint64 {
+	low, okLow := getUInt32Any(e.Entity, "m_OriginalOwnerXuidLow")
+	high, okHigh := getUInt32Any(e.Entity, "m_OriginalOwnerXuidHigh")
+
+	if !okLow || !okHigh {
+		return 0
+	}
+
+	return uint64(high)<<32 | uint64(low)
+}
+
 // UniqueID2 returns a unique id of the equipment element that can be sorted efficiently.
 // UniqueID2 is a value generated internally by this library and can be used to differentiate
 // equipment from each other. This is needed because demo-files reuse entity ids.
-// Unlike UniqueID, UniqueID2 is guaranteed to be unique.
+// UniqueID2 is guaranteed to be unique and never changes, but unlike UniqueID it differs between parsing runs.
 func (e *Equipment) UniqueID2() ulid.ULID {
 	return e.uniqueID2
 }
@@ -635,6 +721,20 @@ func (e *Equipment) ReloadTime() int32 {
 	return ReloadTimeMapping[e.Type]
 }
 
+// MaxPlayerSpeed returns the maximum (unscoped) running speed in units per second of a player holding the equipment,
+// see EquipmentMaxSpeed. Equipment that isn't listed there (e.g. knives and grenades) is treated as 250 units per second.
+func (e *Equipment) MaxPlayerSpeed() float64 {
+	if e == nil {
+		return defaultMaxPlayerSpeed
+	}
+
+	if speed, ok := EquipmentMaxSpeed[e.Type]; ok {
+		return float64(speed)
+	}
+
+	return defaultMaxPlayerSpeed
+}
+
 func (e *Equipment) DemoInfo() demoInfoProvider {
 	return e.demoInfoProvider
 }
@@ -643,13 +743,20 @@ func (e *Equipment) DemoInfo() demoInfoProvider {
 //
 // Intended for internal use only.
 func NewEquipment(wep EquipmentType, demoInfoProvider demoInfoProvider) *Equipment {
-	return &Equipment{
+	eq := &Equipment{
 		Type:             wep,
 		State:            -1,
-		uniqueID:         rand.Int63(),
 		uniqueID2:        ulid.Make(),
 		demoInfoProvider: demoInfoProvider,
-	} //nolint:gosec
+	}
+
+	if demoInfoProvider != nil {
+		eq.creationTick = demoInfoProvider.IngameTick()
+	}
+
+	eq.uniqueID = newUniqueID(nil, demoInfoProvider, eq.creationTick)
+
+	return eq
 }
 
 var equipmentToAlternative = map[EquipmentType]EquipmentType{
@@ -704,6 +811,9 @@ var ReloadTimeMapping = map[EquipmentType]int32{
 	EqXM1014:       203,
 }
 
+// defaultMaxPlayerSpeed is the max running speed of players holding equipment that isn't listed in EquipmentMaxSpeed.
+const defaultMaxPlayerSpeed = 250
+
 // Indexes are available in the game file located at 'scripts/items/items_game.txt'.
 var EquipmentIndexMapping = map[uint64]EquipmentType{
 	1:   EqDeagle,                   // weapon_deagle
@@ -25,7 +25,12 @@ const (
 
 // Hostage represents a hostage.
 type Hostage struct {
-	Entity           st.Entity
+	Entity        st.Entity
+	ID            int       // 1-based, stable across the match. Hostages spawning at the same spawn point share the same ID
+	SpawnPosition r3.Vector // Position at which the hostage spawned
+	RescueTick    int       // Tick at which the hostage was rescued, -1 if it hasn't (yet)
+	RescuedBy     *Player   // Player that rescued the hostage, nil if it hasn't been rescued (yet)
+
 	demoInfoProvider demoInfoProvider
 }
 
@@ -62,10 +67,27 @@ func (hostage *Hostage) Leader() *Player {
 	return hostage.demoInfoProvider.FindPlayerByPawnHandle(getUInt64(hostage.Entity, "m_hHostageGrabber"))
 }
 
+// Carrier returns the player carrying the hostage (or being followed by it).
+// Returns nil if the hostage isn't being carried.
+func (hostage *Hostage) Carrier() *Player {
+	if !hostage.State().IsCarried() {
+		return nil
+	}
+
+	return hostage.Leader()
+}
+
+// IsCarried returns true if the hostage is being carried by or following a player.
+func (state HostageState) IsCarried() bool {
+	return state == HostageStateBeingCarried || state == HostageStateFollowingPlayer
+}
+
 // NewHostage creates a hostage.
 func NewHostage(demoInfoProvider demoInfoProvider, entity st.Entity) *Hostage {
 	return &Hostage{
 		demoInfoProvider: demoInfoProvider,
 		Entity:           entity,
+		SpawnPosition:    entity.Position(),
+		RescueTick:       -1,
 	}
 }
@@ -2,8 +2,9 @@ package common
 
 import (
 	"fmt"
-	"math/rand"
+	"math"
 	"sort"
+	"time"
 
 	"github.com/golang/geo/r2"
 	"github.com/golang/geo/r3"
@@ -17,26 +18,44 @@ import (
 //
 // See also: Inferno.Active() and Fire.IsBurning
 type Inferno struct {
-	Entity    st.Entity
-	Type      int
-	FireCount int
-	Fires     [16]*Fire
-
-	// uniqueID is used to distinguish different infernos (which potentially have the same, reused entityID) from each other.
+	Entity              st.Entity
+	Type                int
+	FireCount           int
+	Fires               [16]*Fire
+	Projectile          *GrenadeProjectile // The projectile that started the inferno, nil if it couldn't be found
+	StartTick           int                // Tick at which the inferno was created
+	ExpiredTick         int                // Tick at which the inferno expired, -1 while it's still burning
+	ExtinguishedBySmoke bool               // True if at least one of the fires was put out by a smoke
+
+	// creationTick is used to distinguish different infernos (which potentially have the same, reused entityID) from each other.
+	creationTick     int
 	uniqueID         int64
 	demoInfoProvider demoInfoProvider
 	thrower          *Player
 }
 
+const (
+	// FireRadius is the approximate horizontal radius around a single fire in which players take damage.
+	FireRadius = 60
+
+	// fireHeight is the approximate height above a fire in which players take damage.
+	fireHeight = 80
+)
+
 // Fire is a component of an Inferno.
 type Fire struct {
-	Position  r3.Vector
-	IsBurning bool
+	Position            r3.Vector
+	IsBurning           bool
+	IgnitionTick        int  // Tick at which the fire started burning, -1 if unknown
+	ExtinguishTick      int  // Tick at which the fire stopped burning, -1 while it's still burning
+	ExtinguishedBySmoke bool // True if the fire was put out by a smoke
 }
 
 func NewFire(pos r3.Vector) *Fire {
 	return &Fire{
-		Position: pos,
+		Position:       pos,
+		IgnitionTick:   -1,
+		ExtinguishTick: -1,
 	}
 }
 
@@ -47,8 +66,11 @@ type Fires struct {
 }
 
 // UniqueID returns the unique id of the inferno.
-// The unique id is a random int generated internally by this library and can be used to differentiate
-// infernos from each other. This is needed because demo-files reuse entity ids.
+// The unique id can be used to differentiate infernos from each other. This is needed because demo-files reuse entity ids.
+// It's derived from the entity and the tick at which the inferno was created, so it's stable across parsing runs.
+// See UniqueIDComponents() for details.
+//
+// The id is computed once in NewInferno(), later changes of Entity don't affect it.
 func (inf *Inferno) UniqueID() int64 {
 	return inf.uniqueID
 }
@@ -60,6 +82,10 @@ func (inf *Inferno) Thrower() *Player {
 		return inf.thrower
 	}
 
+	if inf.Projectile != nil && inf.Projectile.Thrower != nil {
+		return inf.Projectile.Thrower
+	}
+
 	handleProp := inf.Entity.Property("m_hOwnerEntity").Value()
 	return inf.demoInfoProvider.FindPlayerByPawnHandle(handleProp.Handle())
 }
@@ -68,6 +94,61 @@ func (inf *Inferno) DemoInfo() demoInfoProvider {
 	return inf.demoInfoProvider
 }
 
+// BurnDuration returns the time the inferno has been burning for.
+// This is the total duration for infernos that have already expired.
+func (inf *Inferno) BurnDuration() time.Duration {
+	endTick := inf.ExpiredTick
+	if endTick < 0 {
+		endTick = inf.demoInfoProvider.IngameTick()
+	}
+
+	if endTick <= inf.StartTick {
+		return 0
+	}
+
+	return time.Duration(float64(endTick-inf.StartTick) / tickRateOf(inf.demoInfoProvider) * float64(time.Second))
+}
+
+// Area2D returns the area in square units covered by the 2D convex hull of the currently burning fires.
+func (inf *Inferno) Area2D() float64 {
+	return inf.GetFires().Active().Area2D()
+}
+
+// Contains returns true if the given position is inside the area of the currently burning fires.
+// This is an approximation based on the 2D convex hull of the fires, FireRadius around each fire
+// and the height in which players still take damage.
+func (inf *Inferno) Contains(pos r3.Vector) bool {
+	fires := inf.GetFires().Active().List()
+	if len(fires) == 0 {
+		return false
+	}
+
+	minZ, maxZ := math.Inf(1), math.Inf(-1)
+
+	for _, f := range fires {
+		minZ = math.Min(minZ, f.Position.Z)
+		maxZ = math.Max(maxZ, f.Position.Z)
+	}
+
+	if pos.Z < minZ-FireRadius || pos.Z > maxZ+fireHeight {
+		return false
+	}
+
+	p := r2.Point{X: pos.X, Y: pos.Y}
+
+	for _, f := range fires {
+		if p.Sub(r2.Point{X: f.Position.X, Y: f.Position.Y}).Norm() <= FireRadius {
+			return true
+		}
+	}
+
+	if len(fires) < 3 {
+		return false
+	}
+
+	return isInsideConvexPolygon(p, Fires{s: fires}.ConvexHull2D())
+}
+
 // GetFires returns all fires (past + present).
 // Some are currently active and some have extinguished (see Fire.IsBurning).
 func (inf *Inferno) GetFires() Fires {
@@ -81,7 +162,15 @@ func (inf *Inferno) GetFires() Fires {
 		iStr := fmt.Sprintf(iFormat, i)
 
 		fire := Fire{
-			IsBurning: entity.PropertyValueMust("m_bFireIsBurning." + iStr).BoolVal(),
+			IsBurning:      entity.PropertyValueMust("m_bFireIsBurning." + iStr).BoolVal(),
+			IgnitionTick:   -1,
+			ExtinguishTick: -1,
+		}
+
+		if i < len(inf.Fires) && inf.Fires[i] != nil {
+			fire.IgnitionTick = inf.Fires[i].IgnitionTick
+			fire.ExtinguishTick = inf.Fires[i].ExtinguishTick
+			fire.ExtinguishedBySmoke = inf.Fires[i].ExtinguishedBySmoke
 		}
 
 		if prop := entity.Property("m_firePositions." + iStr); prop != nil {
@@ -142,6 +231,47 @@ func (f Fires) ConvexHull2D() []r2.Point {
 	return points
 }
 
+// Area2D returns the area in square units of the 2D convex hull of the fires.
+func (f Fires) Area2D() float64 {
+	if len(f.s) < 3 {
+		return 0
+	}
+
+	points := f.ConvexHull2D()
+
+	// shoelace formula
+	var area float64
+
+	for i, a := range points {
+		b := points[(i+1)%len(points)]
+		area += a.X*b.Y - b.X*a.Y
+	}
+
+	return math.Abs(area) / 2
+}
+
+// isInsideConvexPolygon returns true if p is inside (or on the edge of) the convex polygon with the given ordered corner points.
+func isInsideConvexPolygon(p r2.Point, polygon []r2.Point) bool {
+	var sign float64
+
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+
+		cross := b.Sub(a).Cross(p.Sub(a))
+		if cross == 0 {
+			continue
+		}
+
+		if sign == 0 {
+			sign = cross
+		} else if (cross > 0) != (sign > 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // pointsClockwiseSorter implements the Sort interface for slices of Point
 // with a comparator for sorting points in clockwise order around their center.
 type pointsClockwiseSorter struct {
@@ -213,14 +343,19 @@ func convexHull(pointCloud []r3.Vector) quickhull.ConvexHull {
 	return new(quickhull.QuickHull).ConvexHull(pointCloud, false, false, 0)
 }
 
-// NewInferno creates a inferno and sets the Unique-ID.
+// NewInferno creates a inferno and records the creation tick used for the Unique-ID.
 //
 // Intended for internal use only.
 func NewInferno(demoInfoProvider demoInfoProvider, entity st.Entity, thrower *Player) *Inferno {
+	creationTick := demoInfoProvider.IngameTick()
+
 	return &Inferno{
 		Entity:           entity,
 		Type:             entity.Property("m_nInfernoType").Value().Int(),
-		uniqueID:         rand.Int63(), //nolint:gosec
+		StartTick:        creationTick,
+		ExpiredTick:      -1,
+		creationTick:     creationTick,
+		uniqueID:         newUniqueID(entity, demoInfoProvider, creationTick),
 		demoInfoProvider: demoInfoProvider,
 		thrower:          thrower,
 	}
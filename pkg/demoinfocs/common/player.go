@@ -44,9 +44,12 @@ type Player struct {
 
 	CurrPosition *Position
 	PrevPosition *Position
+	PrevVelocity r3.Vector // Velocity between the two positions before the last position update, see VelocityFromPositions()
 	ViewAngle    r3.Vector
 	FlagState    uint64
 	ActiveWep    *Equipment
+
+	history *playerHistory // nil unless ParserConfig.PlayerHistoryTicks is set
 }
 
 func (p *Player) PlayerPawnEntity() st.Entity {
@@ -742,6 +745,21 @@ func (p *Player) ViewRayDistanceTo(target *Player) float64 {
 	return math.Sqrt(distanceSq)
 }
 
+// ViewAngleTo returns the angle in degrees (0 - 180) between the player's view direction
+// and the direction from the player's eyes to the given position.
+// Unlike ViewRayDistanceTo() the result doesn't depend on the distance to the target.
+func (p *Player) ViewAngleTo(pos r3.Vector) float64 {
+	viewAngles := p.ViewDirection()
+	viewDir := angleToForward(viewAngles.X, viewAngles.Y)
+
+	toTarget := pos.Sub(p.PositionEyes())
+	if toTarget.Norm2() == 0 {
+		return 0
+	}
+
+	return viewDir.Angle(toTarget).Degrees()
+}
+
 // Position returns the in-game coordinates.
 // Note: the Z value is not on the player's eye height but instead at his feet.
 // See also PositionEyes().
@@ -753,50 +771,182 @@ func (p *Player) Position() r3.Vector {
 	return r3.Vector{}
 }
 
+// Default eye heights, used if m_vecViewOffset isn't available.
+const (
+	eyeHeightStanding = 63.839996
+	eyeHeightDucking  = 47.839996
+)
+
+// PositionEyes returns the player's position with the Z value at eye height.
+// Uses the networked view offset if available.
 func (p *Player) PositionEyes() r3.Vector {
-	pos := p.CurrPosition.Position
+	var pos r3.Vector
+
+	if p.CurrPosition != nil {
+		pos = p.CurrPosition.Position
+	} else {
+		pos = p.Position()
+	}
+
+	if offset, ok := getVector(p.PlayerPawnEntity(), "m_vecViewOffset"); ok && offset.Z > 0 {
+		return pos.Add(offset)
+	}
+
 	if p.IsDucking() {
-		pos.Z += 47.839996
+		pos.Z += eyeHeightDucking
 	} else {
-		pos.Z += 63.839996
+		pos.Z += eyeHeightStanding
 	}
+
 	return pos
 }
 
+// Velocity returns the player's velocity in units per second.
+// Uses m_vecAbsVelocity / m_vecVelocity if available, otherwise see VelocityFromPositions().
 func (p *Player) Velocity() r3.Vector {
 	if p == nil {
 		return r3.Vector{}
 	}
 
-	posCurr := p.CurrPosition
-	posPrev := p.PrevPosition
+	pawn := p.PlayerPawnEntity()
 
-	if posCurr == nil || posPrev == nil {
+	for _, prop := range [...]string{"m_vecAbsVelocity", "m_vecVelocity"} {
+		if vel, ok := getVector(pawn, prop); ok {
+			return vel
+		}
+	}
+
+	return p.VelocityFromPositions()
+}
+
+// VelocityFromPositions returns the player's velocity in units per second based on the last two position updates.
+// Returns a zero vector if the player hasn't moved since the last update.
+func (p *Player) VelocityFromPositions() r3.Vector {
+	if p == nil {
 		return r3.Vector{}
 	}
 
-	currentTick := p.demoInfoProvider.IngameTick()
+	return positionDeltaVelocity(p.PrevPosition, p.CurrPosition, p.demoInfoProvider.IngameTick(), p.tickRate())
+}
 
-	if currentTick-posCurr.Tick > 1 {
+// positionDeltaVelocity returns the velocity when moving from prev to curr.
+// Returns a zero vector if no newer position update is expected by currentTick, meaning the player isn't moving.
+func positionDeltaVelocity(prev, curr *Position, currentTick int, tickRate float64) r3.Vector {
+	if prev == nil || curr == nil {
 		return r3.Vector{}
 	}
 
-	deltaTicks := posCurr.Tick - posPrev.Tick
-	if deltaTicks <= 0 {
+	deltaTicks := curr.Tick - prev.Tick
+	if deltaTicks <= 0 || currentTick-curr.Tick > deltaTicks {
 		return r3.Vector{}
 	}
 
-	dx := posCurr.Position.X - posPrev.Position.X
-	dy := posCurr.Position.Y - posPrev.Position.Y
-	dz := posCurr.Position.Z - posPrev.Position.Z
+	return curr.Position.Sub(prev.Position).Mul(tickRate / float64(deltaTicks))
+}
 
-	scale := 64.0
+// DefaultTickRate is the tick-rate that's assumed if the tick-rate of the demo isn't known (yet), see TickRateOrDefault().
+const DefaultTickRate = 64
 
-	return r3.Vector{
-		X: dx * scale,
-		Y: dy * scale,
-		Z: dz * scale,
+// TickRateOrDefault returns the given tick-rate (see Parser.TickRate()) or DefaultTickRate if it isn't known (yet),
+// so it can safely be used to convert between ticks and durations.
+func TickRateOrDefault(tickRate float64) float64 {
+	if tickRate <= 0 {
+		return DefaultTickRate
+	}
+
+	return tickRate
+}
+
+func (p *Player) tickRate() float64 {
+	return tickRateOf(p.demoInfoProvider)
+}
+
+// tickRateOf returns the tick-rate of the demo or DefaultTickRate if it isn't known (yet).
+func tickRateOf(demoInfoProvider demoInfoProvider) float64 {
+	if demoInfoProvider == nil {
+		return DefaultTickRate
+	}
+
+	return TickRateOrDefault(demoInfoProvider.TickRate())
+}
+
+// Speed2D returns the player's horizontal speed in units per second.
+func (p *Player) Speed2D() float64 {
+	vel := p.Velocity()
+
+	return math.Hypot(vel.X, vel.Y)
+}
+
+// Movement constants, see sv_friction, sv_stopspeed & sv_accelerate.
+const (
+	movementFriction     = 5.2
+	movementStopSpeed    = 80
+	movementAccelerate   = 5.5
+	accurateSpeedRatio   = 0.34 // Players are fully accurate below 34% of the max speed of their weapon
+	counterStrafeMinimum = 0.5  // Minimum share of sv_accelerate that needs to be used to slow down when counter-strafing
+)
+
+// IsCounterStrafing returns true if the player is actively slowing down by moving against their current direction.
+// This is detected by the horizontal deceleration being larger than what friction alone could cause.
+// Based on position updates, see VelocityFromPositions() & PrevVelocity.
+func (p *Player) IsCounterStrafing() bool {
+	if p == nil || p.CurrPosition == nil || p.PrevPosition == nil || p.IsAirborne() {
+		return false
+	}
+
+	prev := r3.Vector{X: p.PrevVelocity.X, Y: p.PrevVelocity.Y}
+	prevSpeed := prev.Norm()
+
+	if prevSpeed == 0 {
+		return false
+	}
+
+	curr := p.VelocityFromPositions()
+	curr.Z = 0
+
+	dt := float64(p.CurrPosition.Tick-p.PrevPosition.Tick) / p.tickRate()
+	if dt <= 0 {
+		return false
 	}
+
+	// acceleration against the previous direction of movement
+	deceleration := -curr.Sub(prev).Mul(1 / dt).Dot(prev.Mul(1 / prevSpeed))
+	friction := math.Max(prevSpeed, movementStopSpeed) * movementFriction
+
+	return deceleration > friction+counterStrafeMinimum*movementAccelerate*p.maxSpeed()
+}
+
+// maxSpeed returns the max running speed with the active weapon.
+func (p *Player) maxSpeed() float64 {
+	if p == nil || p.PlayerPawnEntity() == nil || p.demoInfoProvider == nil {
+		return defaultMaxPlayerSpeed
+	}
+
+	if wep := p.ActiveWeapon(); wep != nil {
+		return wep.MaxPlayerSpeed()
+	}
+
+	return defaultMaxPlayerSpeed
+}
+
+// IsShiftWalking returns true if the player is moving on the ground while holding the walk key.
+func (p *Player) IsShiftWalking() bool {
+	return p.IsWalking() && !p.IsAirborne() && p.Speed2D() > 0
+}
+
+// IsMovingInaccurately returns true if the player is moving too fast to be fully accurate with the active weapon.
+// I.e. the player is airborne or faster than 34% of the weapon's max player speed.
+// This can be used with events.WeaponFire to find shots fired while moving.
+func (p *Player) IsMovingInaccurately() bool {
+	if p == nil || p.PlayerPawnEntity() == nil {
+		return false
+	}
+
+	if p.IsAirborne() {
+		return true
+	}
+
+	return p.Speed2D() > p.maxSpeed()*accurateSpeedRatio
 }
 
 // see https://github.com/ValveSoftware/source-sdk-2013/blob/master/mp/src/public/const.h#L146-L188
@@ -982,6 +1132,7 @@ type demoInfoProvider interface {
 	PlayersAliveByEntityID() map[int]*Player
 	Bomb() *Bomb
 	Weapons() map[int]*Equipment
+	NextUniqueIDSequence() int // per-parser sequence number, used for UniqueID() of objects without an entity
 }
 
 // NewPlayer creates a *Player with an initialized equipment map.
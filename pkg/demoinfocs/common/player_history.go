@@ -0,0 +1,159 @@
+package common
+
+import (
+	"math"
+	"sort"
+
+	"github.com/golang/geo/r3"
+)
+
+// PlayerState is a snapshot of a player's state at a given tick.
+// See Player.StateAt().
+type PlayerState struct {
+	Tick         int
+	Position     r3.Vector
+	ViewAngle    r3.Vector // Pitch, yaw & roll in degrees, see Player.ViewAngle
+	Velocity     r3.Vector
+	Health       int
+	Armor        int
+	ActiveWeapon *Equipment // May be nil
+	Flags        PlayerFlags
+	IsAlive      bool
+}
+
+// playerHistory is a ring buffer of player states ordered by tick.
+type playerHistory struct {
+	states []PlayerState
+	start  int // index of the oldest state
+	n      int // number of stored states
+}
+
+func newPlayerHistory(size int) *playerHistory {
+	return &playerHistory{
+		states: make([]PlayerState, size),
+	}
+}
+
+// at returns the i-th oldest state.
+func (h *playerHistory) at(i int) *PlayerState {
+	return &h.states[(h.start+i)%len(h.states)]
+}
+
+func (h *playerHistory) add(state PlayerState) {
+	if h.n > 0 {
+		// multiple updates in the same tick, keep the latest one
+		if last := h.at(h.n - 1); last.Tick == state.Tick {
+			*last = state
+
+			return
+		}
+	}
+
+	if h.n < len(h.states) {
+		*h.at(h.n) = state
+		h.n++
+
+		return
+	}
+
+	h.states[h.start] = state
+	h.start = (h.start + 1) % len(h.states)
+}
+
+// EnableHistory makes the player keep the states of the last size recorded ticks, see StateAt().
+//
+// Intended for internal use only, see ParserConfig.PlayerHistoryTicks.
+func (p *Player) EnableHistory(size int) {
+	if size > 0 && p.history == nil {
+		p.history = newPlayerHistory(size)
+	}
+}
+
+// RecordState adds the player's current state to the history, if enabled.
+//
+// Intended for internal use only.
+func (p *Player) RecordState(tick int) {
+	if p.history == nil {
+		return
+	}
+
+	var pos r3.Vector
+	if p.CurrPosition != nil {
+		pos = p.CurrPosition.Position
+	}
+
+	p.history.add(PlayerState{
+		Tick:         tick,
+		Position:     pos,
+		ViewAngle:    p.ViewAngle,
+		Velocity:     p.Velocity(),
+		Health:       p.Health(),
+		Armor:        p.Armor(),
+		ActiveWeapon: p.ActiveWeapon(),
+		Flags:        PlayerFlags(p.FlagState),
+		IsAlive:      p.IsAlive(),
+	})
+}
+
+// History returns all recorded states of the player, ordered by tick.
+// Returns nil if ParserConfig.PlayerHistoryTicks is not set.
+func (p *Player) History() []PlayerState {
+	if p.history == nil {
+		return nil
+	}
+
+	res := make([]PlayerState, p.history.n)
+	for i := range res {
+		res[i] = *p.history.at(i)
+	}
+
+	return res
+}
+
+// StateAt returns the state of the player at the given tick.
+// Position, velocity and view angle are interpolated if there is no state recorded for the exact tick,
+// all other values are taken from the last state before the tick.
+//
+// Returns false if tick is outside of the recorded history or if ParserConfig.PlayerHistoryTicks is not set.
+func (p *Player) StateAt(tick int) (PlayerState, bool) {
+	h := p.history
+	if h == nil || h.n == 0 || tick < h.at(0).Tick || tick > h.at(h.n-1).Tick {
+		return PlayerState{}, false
+	}
+
+	// index of the first state at or after tick
+	i := sort.Search(h.n, func(i int) bool {
+		return h.at(i).Tick >= tick
+	})
+
+	next := h.at(i)
+	if next.Tick == tick {
+		return *next, true
+	}
+
+	prev := h.at(i - 1)
+	t := float64(tick-prev.Tick) / float64(next.Tick-prev.Tick)
+
+	state := *prev
+	state.Tick = tick
+	state.Position = lerpVector(prev.Position, next.Position, t)
+	state.Velocity = lerpVector(prev.Velocity, next.Velocity, t)
+	state.ViewAngle = r3.Vector{
+		X: lerpAngle(prev.ViewAngle.X, next.ViewAngle.X, t),
+		Y: lerpAngle(prev.ViewAngle.Y, next.ViewAngle.Y, t),
+		Z: lerpAngle(prev.ViewAngle.Z, next.ViewAngle.Z, t),
+	}
+
+	return state, true
+}
+
+func lerpVector(a, b r3.Vector, t float64) r3.Vector {
+	return a.Add(b.Sub(a).Mul(t))
+}
+
+// lerpAngle interpolates between two angles in degrees along the shortest path.
+func lerpAngle(a, b, t float64) float64 {
+	delta := math.Mod(b-a+540, 360) - 180
+
+	return a + delta*t
+}
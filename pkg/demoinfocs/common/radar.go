@@ -0,0 +1,20 @@
+package common
+
+import (
+	"github.com/golang/geo/r3"
+)
+
+// RadarSpottedEntity is an entity shown on the radar as sent via CCSUsrMsg_ProcessSpottedEntityUpdate.
+// This includes spotted players as well as the bomb and dropped defuse kits.
+type RadarSpottedEntity struct {
+	EntityID         int
+	ClassID          int
+	ClassName        string    // Name of the server-class, may be empty if the class is unknown
+	Player           *Player   // Set if the entity is a player, otherwise nil
+	Position         r3.Vector // Radar position, only accurate to a few units
+	Yaw              float64   // View direction in degrees
+	IsDefuser        bool      // True if the entity is a dropped defuse kit
+	PlayerHasDefuser bool
+	PlayerHasC4      bool
+	Tick             int // In-game tick of the last update
+}
@@ -0,0 +1,228 @@
+package common
+
+import (
+	"github.com/golang/geo/r3"
+)
+
+// RoundEndReason is the type for the various RoundEndReasonXYZ constants.
+type RoundEndReason byte
+
+// RoundEndReason constants give information about why a round ended (Bomb defused, exploded etc.).
+const (
+	RoundEndReasonStillInProgress      RoundEndReason = 0
+	RoundEndReasonTargetBombed         RoundEndReason = 1
+	RoundEndReasonVIPEscaped           RoundEndReason = 2
+	RoundEndReasonVIPKilled            RoundEndReason = 3
+	RoundEndReasonTerroristsEscaped    RoundEndReason = 4
+	RoundEndReasonCTStoppedEscape      RoundEndReason = 5
+	RoundEndReasonTerroristsStopped    RoundEndReason = 6
+	RoundEndReasonBombDefused          RoundEndReason = 7
+	RoundEndReasonCTWin                RoundEndReason = 8
+	RoundEndReasonTerroristsWin        RoundEndReason = 9
+	RoundEndReasonDraw                 RoundEndReason = 10
+	RoundEndReasonHostagesRescued      RoundEndReason = 11
+	RoundEndReasonTargetSaved          RoundEndReason = 12
+	RoundEndReasonHostagesNotRescued   RoundEndReason = 13
+	RoundEndReasonTerroristsNotEscaped RoundEndReason = 14
+	RoundEndReasonVIPNotEscaped        RoundEndReason = 15
+	RoundEndReasonGameStart            RoundEndReason = 16
+	RoundEndReasonTerroristsSurrender  RoundEndReason = 17
+	RoundEndReasonCTSurrender          RoundEndReason = 18
+	RoundEndReasonTerroristsPlanted    RoundEndReason = 19
+	RoundEndReasonCTsReachedHostage    RoundEndReason = 20
+)
+
+// Round contains the bookkeeping of a single round of the match.
+// Rounds played during the warmup are not recorded.
+//
+// Ticks of events that haven't happened (yet) are -1.
+type Round struct {
+	Number            int  // 1-based, counting from the start of the match including overtime
+	OvertimeNumber    int  // 0 for regulation, 1 for the first overtime etc.
+	Half              int  // 1-based, counting from the start of the match including overtime halves
+	SidesSwitched     bool // True if the teams switched sides before this round
+	StartTick         int
+	FreezetimeEndTick int
+	EndTick           int
+	OfficialEndTick   int
+
+	Winner     Team // TeamUnassigned if the round hasn't ended yet, TeamSpectators for a draw
+	EndReason  RoundEndReason
+	EndMessage string
+
+	// Teams on each side, see also SideOf().
+	CT RoundTeam
+	T  RoundTeam
+
+	BombPlant   *RoundBombEvent // nil if the bomb wasn't planted
+	BombDefuse  *RoundBombEvent // nil if the bomb wasn't defused
+	BombExplode *RoundBombEvent // nil if the bomb didn't explode
+
+	// Everything that happened to the bomb during the round, in chronological order.
+	BombTimeline []BombTimelineEntry
+
+	Kills []*RoundKill
+
+	// Clutch situations of the round, at most one per team, see Clutch.
+	Clutches []*Clutch
+}
+
+// RoundTeam contains the state of a team during a round.
+type RoundTeam struct {
+	ClanName    string
+	ScoreBefore int // Score at the start of the round
+	ScoreAfter  int // Score at the end of the round, same as ScoreBefore while the round is in progress
+
+	HostagesRescued int
+}
+
+// RoundBombEvent contains information about a bomb plant, defuse or explosion.
+type RoundBombEvent struct {
+	Tick     int
+	Player   *Player // The planter or defuser, may be nil for explosions or with POV demos
+	Site     Bombsite
+	Position r3.Vector // Position of the bomb, or of the player for defuse actions
+}
+
+// BombAction is the type for the various BombActionXYZ constants.
+type BombAction byte
+
+// BombAction constants give information about what happened to the bomb.
+const (
+	BombActionPickup BombAction = iota + 1
+	BombActionDrop
+	BombActionPlantBegin
+	BombActionPlantAbort
+	BombActionPlanted
+	BombActionDefuseBegin
+	BombActionDefuseAbort
+	BombActionDefused
+	BombActionExploded
+)
+
+var strBombActions = map[BombAction]string{
+	BombActionPickup:      "Pickup",
+	BombActionDrop:        "Drop",
+	BombActionPlantBegin:  "PlantBegin",
+	BombActionPlantAbort:  "PlantAbort",
+	BombActionPlanted:     "Planted",
+	BombActionDefuseBegin: "DefuseBegin",
+	BombActionDefuseAbort: "DefuseAbort",
+	BombActionDefused:     "Defused",
+	BombActionExploded:    "Exploded",
+}
+
+func (a BombAction) String() string {
+	if _, exists := strBombActions[a]; !exists {
+		return "Unknown-BombAction"
+	}
+
+	return strBombActions[a]
+}
+
+// BombTimelineEntry is a single entry of Round.BombTimeline.
+// Site is BomsiteUnknown for pickups and drops.
+type BombTimelineEntry struct {
+	Action BombAction
+	RoundBombEvent
+}
+
+// RoundKill contains information about a kill during a round.
+type RoundKill struct {
+	Tick              int
+	Killer            *Player // May be nil for world damage
+	Victim            *Player
+	Assister          *Player // May be nil
+	Weapon            *Equipment
+	IsHeadshot        bool
+	AssistedFlash     bool
+	PenetratedObjects int
+	ThroughSmoke      bool
+	IsTrade           bool       // True if the kill traded the death of a teammate of the killer, see events.TradeKill
+	TradedBy          *RoundKill // The kill that traded this one (the killer was killed shortly after), nil if it wasn't traded
+}
+
+// Clutch is a situation in which a player is the last one alive on their team ("1vX").
+// Clutches end when the round ends, even if the player died before (e.g. after planting the bomb).
+type Clutch struct {
+	Player    *Player // May be a bot, see Player.Controller()
+	Team      Team
+	Opponents int // Number of enemies alive when the clutch started
+	StartTick int
+	EndTick   int // -1 while the round is in progress
+	Kills     int // Enemies killed by Player during the clutch
+	Won       bool
+	Saved     bool // True if the player survived but the round was lost
+}
+
+// IsOver returns true if the round of the clutch has ended.
+func (c *Clutch) IsOver() bool {
+	return c.EndTick != -1
+}
+
+// NewRound creates a new Round with the given number and start tick.
+//
+// Intended for internal use only.
+func NewRound(number, startTick int) *Round {
+	return &Round{
+		Number:            number,
+		StartTick:         startTick,
+		FreezetimeEndTick: -1,
+		EndTick:           -1,
+		OfficialEndTick:   -1,
+	}
+}
+
+// BombEvents returns all entries of the bomb timeline with the given action.
+func (r *Round) BombEvents(action BombAction) []BombTimelineEntry {
+	var res []BombTimelineEntry
+
+	for _, e := range r.BombTimeline {
+		if e.Action == action {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+// HasEnded returns true if the round has ended (RoundEnd), players may still be able to walk around until OfficialEndTick.
+func (r *Round) HasEnded() bool {
+	return r.EndTick != -1
+}
+
+// Team returns the RoundTeam of the given side or nil if team is neither T nor CT.
+func (r *Round) Team(team Team) *RoundTeam {
+	switch team {
+	case TeamCounterTerrorists:
+		return &r.CT
+	case TeamTerrorists:
+		return &r.T
+	default:
+		return nil
+	}
+}
+
+// SideOf returns the side the team with the given clan name played on during the round.
+// Returns TeamUnassigned if no team with this name played the round.
+func (r *Round) SideOf(clanName string) Team {
+	switch clanName {
+	case r.CT.ClanName:
+		return TeamCounterTerrorists
+	case r.T.ClanName:
+		return TeamTerrorists
+	default:
+		return TeamUnassigned
+	}
+}
+
+// Clutch returns the clutch situation of the given team or nil if there wasn't one.
+func (r *Round) Clutch(team Team) *Clutch {
+	for _, c := range r.Clutches {
+		if c.Team == team {
+			return c
+		}
+	}
+
+	return nil
+}
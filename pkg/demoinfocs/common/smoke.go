@@ -1,13 +1,46 @@
 package common
 
 import (
+	"math"
+	"time"
+
+	"github.com/golang/geo/r3"
+
 	st "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
 )
 
+const (
+	// SmokeRadius is the approximate radius of a fully bloomed smoke in world units.
+	SmokeRadius = 144
+
+	// SmokeDuration is the approximate time a smoke lasts if it isn't removed early.
+	// Used to estimate the expiration of active smokes, see Smoke.ExpirationTick().
+	SmokeDuration = 1412 * time.Second / 64
+
+	// SmokeFadeDuration is the approximate time it takes for a smoke to fade away at the end of its lifetime.
+	SmokeFadeDuration = 3 * time.Second
+
+	// SmokeDispersalRadius is the approximate radius of the hole an HE grenade clears in a smoke, in world units.
+	SmokeDispersalRadius = 128
+
+	// SmokeDispersalDuration is the approximate time it takes for a smoke to fill the hole cleared by an HE grenade again.
+	SmokeDispersalDuration = 2 * time.Second
+)
+
+// Smoke is a smoke grenade that has been thrown.
+//
+// The timing and shape of the smoke are estimates: the demo only tells when the smoke pops and when it's removed,
+// so IsDissipating() and Radius() are based on SmokeDuration and SmokeFadeDuration, and the smoke is modelled
+// as a sphere with Radius() around Position().
+// HE grenades exploding inside the smoke clear a hole (see IsDispersed()) that's taken into account by IntersectsLine(),
+// but they don't shorten the estimated lifetime of the smoke.
 type Smoke struct {
-	Entity         st.Entity
-	IsActive       bool
-	ActivationTick int
+	Entity            st.Entity
+	IsActive          bool      // True between the smoke effect starting and the smoke expiring
+	ActivationTick    int       // Tick at which the smoke popped, -1 if it hasn't (yet)
+	ExpiredTick       int       // Tick at which the smoke expired (smokegrenade_expired or entity destroyed), -1 if it hasn't (yet)
+	DispersalTick     int       // Tick of the last HE grenade explosion inside the smoke, -1 if there wasn't any
+	DispersalPosition r3.Vector // Position of the last HE grenade explosion inside the smoke
 
 	demoInfoProvider demoInfoProvider
 	thrower          *Player
@@ -24,11 +57,126 @@ func (smk *Smoke) Thrower() *Player {
 	return smk.demoInfoProvider.FindPlayerByPawnHandle(handleProp.Handle())
 }
 
+// ExpirationTick returns the tick at which the smoke expired.
+// While the smoke is still active this is an estimate based on SmokeDuration and the tick-rate.
+// Returns -1 if the smoke hasn't popped yet.
 func (smk *Smoke) ExpirationTick() int {
+	if smk.ExpiredTick >= 0 {
+		return smk.ExpiredTick
+	}
+
 	if !smk.IsActive {
 		return -1
 	}
-	return smk.ActivationTick + 1412
+
+	return smk.ActivationTick + smk.durationTicks(SmokeDuration)
+}
+
+// Position returns the position at which the smoke detonated.
+func (smk *Smoke) Position() r3.Vector {
+	if pos, ok := getVector(smk.Entity, "m_vSmokeDetonationPos"); ok && pos != (r3.Vector{}) {
+		return pos
+	}
+
+	return smk.Entity.Position()
+}
+
+// IsDissipating returns true if the smoke is active and expected to be fading away, see SmokeFadeDuration.
+func (smk *Smoke) IsDissipating() bool {
+	if !smk.IsActive {
+		return false
+	}
+
+	return smk.demoInfoProvider.IngameTick() >= smk.ExpirationTick()-smk.durationTicks(SmokeFadeDuration)
+}
+
+// Radius returns the approximate radius of the smoke in world units.
+// Returns 0 if the smoke isn't active, the radius shrinks linearly while the smoke is dissipating.
+func (smk *Smoke) Radius() float64 {
+	if !smk.IsActive {
+		return 0
+	}
+
+	remaining := smk.ExpirationTick() - smk.demoInfoProvider.IngameTick()
+	fade := smk.durationTicks(SmokeFadeDuration)
+
+	if remaining >= fade || fade <= 0 {
+		return SmokeRadius
+	}
+
+	if remaining <= 0 {
+		return 0
+	}
+
+	return SmokeRadius * float64(remaining) / float64(fade)
+}
+
+// IsDispersed returns true if an HE grenade exploded inside the smoke less than SmokeDispersalDuration ago,
+// i.e. if there is a hole with SmokeDispersalRadius around DispersalPosition.
+func (smk *Smoke) IsDispersed() bool {
+	if !smk.IsActive || smk.DispersalTick < 0 {
+		return false
+	}
+
+	return smk.demoInfoProvider.IngameTick()-smk.DispersalTick < smk.durationTicks(SmokeDispersalDuration)
+}
+
+// BoundingBox returns the corners of an axis-aligned box containing the smoke.
+// This is only an approximation, the smoke is modelled as a sphere with Radius() around Position().
+func (smk *Smoke) BoundingBox() (minCorner r3.Vector, maxCorner r3.Vector) {
+	pos := smk.Position()
+	r := r3.Vector{X: smk.Radius(), Y: smk.Radius(), Z: smk.Radius()}
+
+	return pos.Sub(r), pos.Add(r)
+}
+
+// IntersectsLine returns true if the line segment between a and b passes through the smoke.
+// See BoundingBox() for the approximation that's used.
+// While the smoke is dispersed (see IsDispersed()), segments that only pass through the cleared hole don't intersect it.
+func (smk *Smoke) IntersectsLine(a, b r3.Vector) bool {
+	radius := smk.Radius()
+	if radius <= 0 {
+		return false
+	}
+
+	center := smk.Position()
+	ab := b.Sub(a)
+	lenSq := ab.Norm2()
+
+	if lenSq == 0 {
+		return a.Sub(center).Norm2() <= radius*radius && !smk.isInDispersal(a)
+	}
+
+	// part of the segment a-b inside the sphere, a + t*ab with t in [t0, t1]
+	ac := a.Sub(center)
+	half := ac.Dot(ab) / lenSq
+	disc := half*half - (ac.Norm2()-radius*radius)/lenSq
+
+	if disc < 0 {
+		return false
+	}
+
+	t0 := math.Max(0, -half-math.Sqrt(disc))
+	t1 := math.Min(1, -half+math.Sqrt(disc))
+
+	if t0 > t1 {
+		return false
+	}
+
+	// the hole is convex, so the part inside the sphere is clear if both of its ends are in the hole
+	return !smk.isInDispersal(a.Add(ab.Mul(t0))) || !smk.isInDispersal(a.Add(ab.Mul(t1)))
+}
+
+func (smk *Smoke) isInDispersal(pos r3.Vector) bool {
+	if !smk.IsDispersed() {
+		return false
+	}
+
+	return pos.Sub(smk.DispersalPosition).Norm2() <= SmokeDispersalRadius*SmokeDispersalRadius
+}
+
+func (smk *Smoke) durationTicks(d time.Duration) int {
+	return int(math.Round(d.Seconds() * tickRateOf(smk.demoInfoProvider)))
 }
 
 func NewSmoke(demoInfoProvider demoInfoProvider, entity st.Entity, thrower *Player) *Smoke {
@@ -36,6 +184,8 @@ func NewSmoke(demoInfoProvider demoInfoProvider, entity st.Entity, thrower *Play
 		Entity:           entity,
 		IsActive:         false,
 		ActivationTick:   -1,
+		ExpiredTick:      -1,
+		DispersalTick:    -1,
 		demoInfoProvider: demoInfoProvider,
 		thrower:          thrower,
 	}
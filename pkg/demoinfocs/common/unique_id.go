@@ -0,0 +1,81 @@
+package common
+
+import (
+	st "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
+)
+
+// Unique IDs
+//
+// Demo files reuse entity indices, so grenade projectiles, infernos and equipment can't be told apart by
+// their entity ID alone. Their UniqueID() is therefore derived from the entity index, the entity's serial number
+// (which is incremented every time an index is reused) and the in-game tick at which the object was created:
+//
+//	bits  0-13: entity index
+//	bits 14-30: serial number
+//	bits 31-61: creation tick
+//	bit     62: set if the object isn't backed by an entity
+//
+// The highest bit is always 0, so IDs are always positive.
+// As all three components come from the demo itself, parsing the same demo twice yields the same IDs,
+// which allows joining results of different parsing runs.
+//
+// Objects that aren't backed by an entity (e.g. equipment created from game events) have bit 62 set
+// and use a per-parser sequence number instead of the entity index and serial number.
+const (
+	uniqueIDIndexBits  = 14
+	uniqueIDSerialBits = 17
+	uniqueIDTickBits   = 31
+
+	uniqueIDIndexMask  = 1<<uniqueIDIndexBits - 1
+	uniqueIDSerialMask = 1<<uniqueIDSerialBits - 1
+	uniqueIDTickMask   = 1<<uniqueIDTickBits - 1
+
+	// uniqueIDNoEntityFlag is set for objects that don't have an entity.
+	uniqueIDNoEntityFlag = 1 << (uniqueIDIndexBits + uniqueIDSerialBits + uniqueIDTickBits)
+)
+
+func makeUniqueID(index, serial, creationTick int) int64 {
+	return int64(index&uniqueIDIndexMask) |
+		int64(serial&uniqueIDSerialMask)<<uniqueIDIndexBits |
+		int64(creationTick&uniqueIDTickMask)<<(uniqueIDIndexBits+uniqueIDSerialBits)
+}
+
+// entityUniqueID returns the unique ID for an object backed by the given entity.
+// If entity is nil, the sequence number is used to distinguish objects created in the same tick.
+func entityUniqueID(entity st.Entity, sequence, creationTick int) int64 {
+	if entity == nil {
+		return uniqueIDNoEntityFlag | makeUniqueID(0, sequence, creationTick)
+	}
+
+	return makeUniqueID(entity.ID(), entity.SerialNum(), creationTick)
+}
+
+// newUniqueID returns the unique ID for an object created at the given tick.
+// Objects without an entity get the next sequence number of the parser, so their IDs don't collide.
+func newUniqueID(entity st.Entity, demoInfoProvider demoInfoProvider, creationTick int) int64 {
+	if entity != nil {
+		return entityUniqueID(entity, 0, creationTick)
+	}
+
+	var sequence int
+	if demoInfoProvider != nil {
+		sequence = demoInfoProvider.NextUniqueIDSequence()
+	}
+
+	return entityUniqueID(nil, sequence, creationTick)
+}
+
+// UniqueIDComponents splits a unique ID (see GrenadeProjectile.UniqueID(), Inferno.UniqueID() and Equipment.UniqueID())
+// into the entity index, serial number (or sequence number for objects without an entity) and creation tick.
+// The entity index is -1 for objects that aren't backed by an entity.
+func UniqueIDComponents(id int64) (entityIndex, serial, creationTick int) {
+	entityIndex = int(id & uniqueIDIndexMask)
+	serial = int(id >> uniqueIDIndexBits & uniqueIDSerialMask)
+	creationTick = int(id >> (uniqueIDIndexBits + uniqueIDSerialBits) & uniqueIDTickMask)
+
+	if id&uniqueIDNoEntityFlag != 0 {
+		entityIndex = -1
+	}
+
+	return
+}
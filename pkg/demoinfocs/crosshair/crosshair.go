@@ -0,0 +1,201 @@
+// Package crosshair analyses the crosshair placement of players.
+//
+// Every frame, the angle between each player's view direction and the head of the nearest enemy they can see
+// (see Player.IsSpottedBy()) is recorded, see Player.ViewAngleTo(). The smaller the angle, the less a player
+// has to move their crosshair to aim at the enemy. The angles are summarized per player and round.
+//
+// Additionally the angles at the start of engagements (see engagement.Participant.SpotAngle) can be summarized
+// with EngagementSummary().
+//
+// Example:
+//
+//	analyzer := crosshair.NewAnalyzer(parser)
+//	parser.ParseToEnd()
+//
+//	for _, rs := range analyzer.Rounds() {
+//		fmt.Printf("round %d, %s: mean %.1f° median %.1f°\n", rs.Round, rs.Player, rs.Mean, rs.Median)
+//	}
+package crosshair
+
+import (
+	"sort"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/engagement"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// Summary contains statistics of a set of angles (in degrees).
+type Summary struct {
+	Samples int
+	Mean    float64
+	Median  float64
+}
+
+// Summarize returns the summary of the given angles.
+func Summarize(angles []float64) Summary {
+	if len(angles) == 0 {
+		return Summary{}
+	}
+
+	sorted := make([]float64, len(angles))
+	copy(sorted, angles)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, a := range sorted {
+		sum += a
+	}
+
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + median) / 2
+	}
+
+	return Summary{
+		Samples: len(sorted),
+		Mean:    sum / float64(len(sorted)),
+		Median:  median,
+	}
+}
+
+// RoundSummary contains the crosshair placement of a player during a single round.
+type RoundSummary struct {
+	Round  int
+	Player *common.Player
+	Summary
+}
+
+// Analyzer records the crosshair placement of all players during parsing.
+type Analyzer struct {
+	parser demoinfocs.Parser
+
+	rounds  []int                                // Round numbers in order of appearance
+	samples map[int]map[*common.Player][]float64 // Maps round numbers to the angles of each player
+}
+
+// NewAnalyzer creates an Analyzer and registers its event handlers on the parser.
+// Frames outside of rounds (e.g. during the warmup) are ignored.
+func NewAnalyzer(parser demoinfocs.Parser) *Analyzer {
+	a := &Analyzer{
+		parser:  parser,
+		samples: make(map[int]map[*common.Player][]float64),
+	}
+
+	parser.RegisterEventHandler(a.frameDone)
+
+	return a
+}
+
+// Rounds returns the crosshair placement of each player per round, ordered by round and UserID.
+func (a *Analyzer) Rounds() []RoundSummary {
+	var res []RoundSummary
+
+	for _, number := range a.rounds {
+		players := make([]*common.Player, 0, len(a.samples[number]))
+		for pl := range a.samples[number] {
+			players = append(players, pl)
+		}
+
+		sort.Slice(players, func(i, j int) bool {
+			return players[i].UserID < players[j].UserID
+		})
+
+		for _, pl := range players {
+			res = append(res, RoundSummary{
+				Round:   number,
+				Player:  pl,
+				Summary: Summarize(a.samples[number][pl]),
+			})
+		}
+	}
+
+	return res
+}
+
+// Player returns the crosshair placement of the given player over all rounds.
+func (a *Analyzer) Player(pl *common.Player) Summary {
+	var angles []float64
+
+	for _, number := range a.rounds {
+		angles = append(angles, a.samples[number][pl]...)
+	}
+
+	return Summarize(angles)
+}
+
+// AngleToNearestVisibleEnemy returns the angle between the player's view direction and the head of the nearest
+// enemy that the player can see. The second return value is false if the player can't see any enemy.
+func AngleToNearestVisibleEnemy(pl *common.Player, players []*common.Player) (float64, bool) {
+	var (
+		nearest   *common.Player
+		nearestSq float64
+		eyes      = pl.PositionEyes()
+	)
+
+	for _, enemy := range players {
+		if enemy.Team == pl.Team || !enemy.IsAlive() || !enemy.IsSpottedBy(pl) {
+			continue
+		}
+
+		distSq := enemy.PositionEyes().Sub(eyes).Norm2()
+		if nearest == nil || distSq < nearestSq || (distSq == nearestSq && enemy.UserID < nearest.UserID) {
+			nearest, nearestSq = enemy, distSq
+		}
+	}
+
+	if nearest == nil {
+		return 0, false
+	}
+
+	return pl.ViewAngleTo(nearest.PositionEyes()), true
+}
+
+// EngagementSummary returns the summary of the given player's angles at the start of the given engagements.
+// Engagements the player didn't take part in are ignored.
+func EngagementSummary(engagements []*engagement.Engagement, pl *common.Player) Summary {
+	var angles []float64
+
+	for _, e := range engagements {
+		if p := e.Participant(pl); p != nil {
+			angles = append(angles, p.SpotAngle)
+		}
+	}
+
+	return Summarize(angles)
+}
+
+func (a *Analyzer) frameDone(events.FrameDone) {
+	gs := a.parser.GameState()
+
+	round := gs.CurrentRound()
+	if round == nil || round.HasEnded() {
+		return
+	}
+
+	if len(a.rounds) == 0 || a.rounds[len(a.rounds)-1] != round.Number {
+		// mp_restartgame or a restored round backup
+		for len(a.rounds) > 0 && a.rounds[len(a.rounds)-1] >= round.Number {
+			delete(a.samples, a.rounds[len(a.rounds)-1])
+			a.rounds = a.rounds[:len(a.rounds)-1]
+		}
+
+		a.rounds = append(a.rounds, round.Number)
+		a.samples[round.Number] = make(map[*common.Player][]float64)
+	}
+
+	perPlayer := a.samples[round.Number]
+
+	players := gs.Participants().Playing()
+
+	for _, pl := range players {
+		if !pl.IsAlive() {
+			continue
+		}
+
+		if angle, visible := AngleToNearestVisibleEnemy(pl, players); visible {
+			perPlayer[pl] = append(perPlayer[pl], angle)
+		}
+	}
+}
@@ -100,6 +100,12 @@ func (p *parser) bindBomb() {
 		p.gameState.currentPlanter = nil
 
 		bomb.LastOnGroundPosition = bombEntity.Position()
+		bomb.PlantedEntity = bombEntity
+		bomb.PlantTick = p.gameState.ingameTick
+
+		if timer, err := p.gameState.rules.BombTime(); err == nil {
+			bomb.Timer = timer
+		}
 
 		ownerProp := bombEntity.PropertyValueMust("m_hOwnerEntity")
 
@@ -236,6 +242,10 @@ func (p *parser) bindBomb() {
 		bombEntity.OnDestroy(func() {
 			isTicking = true
 			p.gameState.currentDefuser = nil
+
+			if bomb.PlantedEntity == bombEntity {
+				bomb.PlantedEntity = nil
+			}
 		})
 	})
 }
@@ -306,6 +316,7 @@ func (p *parser) bindBombSites() {
 	})
 
 	onBombTargetEntityCreated := func(target st.Entity) {
+		// m_vecMins / m_vecMaxs are world coordinates, they're compared with m_bombsiteCenterA/B in bombEvent()
 		t := new(boundingBoxInformation)
 		p.triggers[target.ID()] = t
 
@@ -479,7 +490,16 @@ func (p *parser) bindNewPlayerControllerS2(controllerEntity st.Entity) {
 		p.gameState.setPlayerLifeState(pl, nil)
 	})
 
+	lastMoney := -1
+
 	controllerEntity.Property("m_pInGameMoneyServices.m_iAccount").OnUpdate(func(pv st.PropertyValue) {
+		money := pv.Int()
+		if lastMoney >= 0 && money < lastMoney {
+			p.gameState.addMoneyDecrease(pl, lastMoney-money)
+		}
+
+		lastMoney = money
+
 		p.eventDispatcher.Dispatch(events.MoneyUpdate{
 			Player: pl,
 			Money:  pv.Int(),
@@ -699,6 +719,11 @@ func (p *parser) bindNewPlayerPawnS2(pawnEntity st.Entity) {
 			}
 		}
 
+		if pl.ActiveWep != nil && pl.ActiveWep != wep {
+			// switching weapons cancels reloads
+			p.weaponReloadEnd(pl.ActiveWep)
+		}
+
 		pl.ActiveWep = wep
 	})
 
@@ -946,7 +971,7 @@ func (p *parser) bindGrenadeProjectiles(entity st.Entity) {
 
 	_, ok := p.gameState.grenadeProjectiles[entityID]
 	proj := common.NewGrenadeProjectile(p.demoInfoProvider)
-	proj.Entity = entity
+	proj.AttachEntity(entity)
 	p.gameState.grenadeProjectiles[entityID] = proj
 
 	ownerEntVal := entity.PropertyValueMust("m_hOwnerEntity")
@@ -972,6 +997,10 @@ func (p *parser) bindGrenadeProjectiles(entity st.Entity) {
 	proj.InitialPosition = entity.Property("m_vInitialPosition").Value().R3Vec()
 	proj.InitialVelocity = entity.Property("m_vInitialVelocity").Value().R3Vec()
 
+	entity.OnPositionUpdate(func(pos r3.Vector) {
+		p.addTrajectoryEntry(proj, pos, false)
+	})
+
 	var wep common.EquipmentType
 	entity.OnCreateFinished(func() { //nolint:wsl
 		proj = p.gameState.grenadeProjectiles[entity.ID()]
@@ -1098,6 +1127,7 @@ func (p *parser) bindGrenadeProjectiles(entity st.Entity) {
 			bounceNumber := val.Int()
 			if bounceNumber != proj.Bouces {
 				proj.Bouces = bounceNumber
+				p.addTrajectoryEntry(proj, proj.Position(), true)
 				p.eventDispatcher.Dispatch(events.GrenadeProjectileBounce{
 					Projectile: proj,
 					BounceNr:   bounceNumber,
@@ -1107,6 +1137,28 @@ func (p *parser) bindGrenadeProjectiles(entity st.Entity) {
 	}
 }
 
+// addTrajectoryEntry records the position of a projectile for the current tick.
+// Multiple updates within the same tick are merged into a single entry.
+func (p *parser) addTrajectoryEntry(proj *common.GrenadeProjectile, pos r3.Vector, isBounce bool) {
+	tick := p.gameState.ingameTick
+
+	if n := len(proj.Trajectory); n > 0 && proj.Trajectory[n-1].Tick == tick {
+		last := &proj.Trajectory[n-1]
+		last.Position = pos
+		last.IsBounce = last.IsBounce || isBounce
+
+		return
+	}
+
+	proj.Trajectory = append(proj.Trajectory, common.TrajectoryEntry{
+		Position: pos,
+		Tick:     tick,
+		FrameID:  p.currentFrame,
+		Time:     p.CurrentTime(),
+		IsBounce: isBounce,
+	})
+}
+
 // Separate function because we also use it in round_officially_ended (issue #42)
 func (p *parser) nadeProjectileDestroyed(proj *common.GrenadeProjectile) {
 	// If the grenade projectile entity is destroyed AFTER round_officially_ended
@@ -1129,6 +1181,16 @@ func (p *parser) nadeProjectileDestroyed(proj *common.GrenadeProjectile) {
 	if !isInferno && !isSmoke && !isDecoy {
 		p.gameEventHandler.deleteThrownGrenade(proj.Thrower, proj.WeaponInstance.Type)
 	}
+
+	// Remember fire grenades for a bit so the inferno that's created afterwards can be linked to them
+	if isInferno {
+		p.pruneDestroyedFireProjectiles()
+
+		p.gameState.destroyedFireProjectiles = append(p.gameState.destroyedFireProjectiles, destroyedProjectile{
+			projectile: proj,
+			tick:       p.gameState.ingameTick,
+		})
+	}
 }
 
 func (p *parser) bindWeaponS2(entity st.Entity) {
@@ -1168,6 +1230,7 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 		// The second case can happen if old weapon entity is deleted in the same frame
 		// as a new weapon entity is created. We have to replace old obsolete weapon with a new one.
 		equipment = common.NewEquipment(wepType, p.demoInfoProvider)
+		equipment.AttachEntity(entity)
 		p.gameState.weapons[entityID] = equipment
 
 		// As we might have replaced old equipment with a new one we have to
@@ -1191,6 +1254,8 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 			}
 		}
 	} else {
+		// placeholder equipment created from the owner's inventory, UniqueID() must be final before it's dispatched
+		equipment.AttachEntity(entity)
 		equipment.Type = wepType
 
 		if equipment.Owner != nil {
@@ -1208,7 +1273,6 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 		}
 	}
 
-	equipment.Entity = entity
 	equipment.EntityId = entityID
 	equipment.Skin = equipment.GetSkin()
 
@@ -1222,6 +1286,31 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 		lastMoneyIncreased  bool
 	)
 
+	// Used to detect purchases and items bought for teammates, see events.ItemPurchase and events.ItemGift
+	var (
+		buyer         *common.Player
+		purchaseRound int
+		gifted        bool
+	)
+
+	p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+		var cost int
+
+		buyer, cost = p.findItemBuyer(equipment)
+		if buyer == nil {
+			return
+		}
+
+		purchaseRound = p.gameState.CurrentRound().Number
+
+		p.eventDispatcher.Dispatch(events.ItemPurchase{
+			Buyer: buyer,
+			Item:  equipment,
+			Cost:  cost,
+			Tick:  p.gameState.ingameTick,
+		})
+	})
+
 	entity.Property("m_hOwnerEntity").OnUpdate(func(val st.PropertyValue) {
 		if val.Any == nil {
 			return
@@ -1229,9 +1318,30 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 
 		owner := p.GameState().Participants().FindByPawnHandle(val.Handle())
 
+		if owner != nil && buyer != nil && !gifted && owner != buyer {
+			gifted = true
+
+			round := p.gameState.CurrentRound()
+			if round != nil && round.Number == purchaseRound && owner.Team == buyer.Team {
+				gift := events.ItemGift{
+					Buyer:     buyer,
+					Recipient: owner,
+					Item:      equipment,
+				}
+
+				p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+					p.eventDispatcher.Dispatch(gift)
+				})
+			}
+		}
+
 		prevOwner := equipment.Owner
 		equipment.Owner = owner
 
+		if prevOwner != owner {
+			p.weaponReloadEnd(equipment)
+		}
+
 		if owner == nil {
 			p.eventDispatcher.Dispatch(events.ItemDroped{
 				Owner: prevOwner,
@@ -1301,8 +1411,12 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 
 		lastMoneyIncreased = false
 		p.gameState.wepsToRemove[entityID] = equipment
+
+		p.weaponReloadEnd(equipment)
 	})
 
+	p.bindWeaponReload(entity, equipment)
+
 	// Detect weapon firing, we don't use m_iClip1 because it would not work with weapons such as the knife (no ammo).
 	// WeaponFire events for grenades are dispatched when the grenade's projectile is created.
 	if equipment.Class() != common.EqClassGrenade && !p.disableMimicSource1GameEvents {
@@ -1333,6 +1447,152 @@ func (p *parser) bindWeaponS2(entity st.Entity) {
 	}
 }
 
+// shellReloadCapacity maps weapons that are reloaded one shell at a time to their magazine capacity.
+var shellReloadCapacity = map[common.EquipmentType]int{
+	common.EqNova:     8,
+	common.EqXM1014:   7,
+	common.EqSawedOff: 7,
+}
+
+// bindWeaponReload detects reloads from m_bInReload.
+// If the prop isn't available, reloads are started by the weapon_reload game event and ended by m_iClip1 increasing.
+// With disableMimicSource1GameEvents reloads are always started by the game event but still ended by m_bInReload.
+func (p *parser) bindWeaponReload(entity st.Entity, equipment *common.Equipment) {
+	if equipment.Class() == common.EqClassGrenade || equipment.Class() == common.EqClassEquipment {
+		return
+	}
+
+	if _, ok := entity.PropertyValue("m_bInReload"); ok {
+		entity.Property("m_bInReload").OnUpdate(func(val st.PropertyValue) {
+			if val.Any == nil {
+				return
+			}
+
+			if val.BoolVal() {
+				// with disableMimicSource1GameEvents the reload is started by the weapon_reload game event
+				if !p.disableMimicSource1GameEvents {
+					p.weaponReloadBegin(equipment.Owner, equipment)
+				}
+
+				return
+			}
+
+			// m_iClip1 may be updated after m_bInReload
+			p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+				p.weaponReloadEnd(equipment)
+			})
+		})
+
+		return
+	}
+
+	entity.Property("m_iClip1").OnUpdate(func(val st.PropertyValue) {
+		reload, ok := p.gameState.reloadingWeapons[equipment.EntityId]
+		if val.Any == nil || !ok {
+			return
+		}
+
+		ammo := int(val.S2UInt32())
+		if ammo <= reload.ammoBefore {
+			return
+		}
+
+		// shotguns are reloaded shell by shell, the reload is over once they're full or out of reserve ammo
+		// (or when it's interrupted, see weaponReloadEnd() calls)
+		if capacity, shellByShell := shellReloadCapacity[equipment.Type]; shellByShell && ammo < capacity && equipment.AmmoReserve() > 0 {
+			return
+		}
+
+		p.weaponReloadEnd(equipment)
+	})
+}
+
+func (p *parser) weaponReloadBegin(pl *common.Player, wep *common.Equipment) {
+	if _, ok := p.gameState.reloadingWeapons[wep.EntityId]; ok {
+		return
+	}
+
+	ammo := wep.AmmoInMagazine()
+
+	p.gameState.reloadingWeapons[wep.EntityId] = weaponReload{
+		player:     pl,
+		ammoBefore: ammo,
+	}
+
+	p.eventDispatcher.Dispatch(events.WeaponReloadBegin{
+		Player:         pl,
+		Weapon:         wep,
+		AmmoInMagazine: ammo,
+		AmmoReserve:    wep.AmmoReserve(),
+	})
+}
+
+func (p *parser) weaponReloadEnd(wep *common.Equipment) {
+	reload, ok := p.gameState.reloadingWeapons[wep.EntityId]
+	if !ok {
+		return
+	}
+
+	delete(p.gameState.reloadingWeapons, wep.EntityId)
+
+	ammo := wep.AmmoInMagazine()
+
+	p.eventDispatcher.Dispatch(events.WeaponReloadEnd{
+		Player:     reload.player,
+		Weapon:     wep,
+		Success:    ammo > reload.ammoBefore,
+		AmmoBefore: reload.ammoBefore,
+		AmmoAfter:  ammo,
+	})
+}
+
+// findItemBuyer returns the player that bought the given (newly created) item and the price paid, if it was bought.
+// The buyer is the original owner of the item (or its owner for bots, which don't have a SteamID)
+// and their money must have decreased by at least the item's price during the current tick.
+// The price is deducted from the decrease so it isn't attributed to other items created in the same tick.
+// Items handed out at the start of a round (e.g. default pistols) are not purchases even if the money was reset.
+func (p *parser) findItemBuyer(equipment *common.Equipment) (*common.Player, int) {
+	gs := p.gameState
+
+	if round := gs.CurrentRound(); round == nil || round.StartTick == gs.ingameTick {
+		return nil, 0
+	}
+
+	var candidate *common.Player
+
+	if xuid := equipment.OriginalOwnerSteamID64(); xuid != 0 {
+		candidate = gs.playersBySteamID32[common.ConvertSteamID64To32(xuid)]
+	}
+
+	if candidate == nil {
+		candidate = equipment.Owner
+	}
+
+	if candidate == nil {
+		return nil, 0
+	}
+
+	decrease, ok := gs.moneyDecreases[candidate]
+	if !ok || decrease.tick != gs.ingameTick {
+		return nil, 0
+	}
+
+	cost, known := common.EquipmentCost[equipment.Type]
+	if !known {
+		cost = decrease.amount
+	}
+
+	if cost <= 0 || decrease.amount < cost {
+		return nil, 0
+	}
+
+	// the decrease can only pay for the items it covers, e.g. one $2700 decrease can't buy two AK-47s
+	decrease.amount -= cost
+	gs.moneyDecreases[candidate] = decrease
+
+	return candidate, cost
+}
+
 func (p *parser) bindNewInferno(entity st.Entity) {
 	ownerEntVal := entity.PropertyValueMust("m_hOwnerEntity")
 	if ownerEntVal.Any == nil {
@@ -1353,6 +1613,8 @@ func (p *parser) bindNewInferno(entity st.Entity) {
 			return
 		}
 
+		inf.Projectile = p.findInfernoProjectile(inf)
+
 		p.eventDispatcher.Dispatch(events.InfernoStart{
 			Inferno: inf,
 		})
@@ -1382,6 +1644,20 @@ func (p *parser) bindNewInferno(entity st.Entity) {
 				return
 			}
 			fire.IsBurning = isBurning
+
+			if isBurning {
+				fire.IgnitionTick = p.gameState.ingameTick
+				fire.ExtinguishTick = -1
+				fire.ExtinguishedBySmoke = false
+			} else {
+				fire.ExtinguishTick = p.gameState.ingameTick
+
+				// smokes may only become active later in the same frame
+				p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+					p.checkFireExtinguishedBySmoke(inf, fire)
+				})
+			}
+
 			p.eventDispatcher.Dispatch(events.InfernoFireStart{
 				Inferno: inf,
 				Index:   index,
@@ -1403,6 +1679,17 @@ func (p *parser) infernoExpired(inf *common.Inferno) {
 		return
 	}
 
+	inf.ExpiredTick = p.gameState.ingameTick
+
+	var burning []*common.Fire
+
+	for _, fire := range inf.Fires {
+		if fire != nil && fire.IsBurning {
+			fire.ExtinguishTick = inf.ExpiredTick
+			burning = append(burning, fire)
+		}
+	}
+
 	p.eventDispatcher.Dispatch(events.InfernoExpired{
 		Inferno: inf,
 	})
@@ -1410,6 +1697,110 @@ func (p *parser) infernoExpired(inf *common.Inferno) {
 	delete(p.gameState.infernos, inf.Entity.ID())
 
 	p.gameEventHandler.deleteThrownGrenade(inf.Thrower(), common.EqIncendiary)
+
+	// a smoke that puts out the fire may only become active later in the same frame
+	p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+		for _, fire := range burning {
+			p.checkFireExtinguishedBySmoke(inf, fire)
+		}
+	})
+}
+
+// checkFireExtinguishedBySmoke marks the fire (and its inferno) as extinguished by smoke if it's inside an active smoke
+// and dispatches InfernoFireExtinguishedBySmoke.
+func (p *parser) checkFireExtinguishedBySmoke(inf *common.Inferno, fire *common.Fire) {
+	for _, smk := range p.gameState.smokes {
+		if smk.IsActive && smk.IntersectsLine(fire.Position, fire.Position) {
+			fire.ExtinguishedBySmoke = true
+			inf.ExtinguishedBySmoke = true
+
+			p.eventDispatcher.Dispatch(events.InfernoFireExtinguishedBySmoke{
+				Inferno: inf,
+				Fire:    fire,
+				Smoke:   smk,
+			})
+
+			return
+		}
+	}
+}
+
+// findInfernoProjectile returns the molotov / incendiary projectile that started the inferno.
+// This is the closest fire grenade of the same thrower that is still flying or was destroyed recently.
+// Ties are broken by the projectiles' UniqueID() so the result is deterministic.
+func (p *parser) findInfernoProjectile(inf *common.Inferno) *common.GrenadeProjectile {
+	p.pruneDestroyedFireProjectiles()
+
+	var candidates []*common.GrenadeProjectile
+
+	for _, proj := range p.gameState.grenadeProjectiles {
+		if isFireGrenadeProjectile(proj) {
+			candidates = append(candidates, proj)
+		}
+	}
+
+	for _, destroyed := range p.gameState.destroyedFireProjectiles {
+		candidates = append(candidates, destroyed.projectile)
+	}
+
+	var (
+		thrower     = inf.Thrower()
+		infPos      = inf.Entity.Position()
+		best        *common.GrenadeProjectile
+		minDistance = math.MaxFloat64
+	)
+
+	for _, proj := range candidates {
+		if thrower != nil && proj.Thrower != nil && proj.Thrower != thrower {
+			continue
+		}
+
+		pos := proj.Position()
+		if n := len(proj.Trajectory); n > 0 {
+			pos = proj.Trajectory[n-1].Position
+		}
+
+		distance := getDistanceBetweenVectors(pos, infPos)
+		if distance < minDistance || (distance == minDistance && proj.UniqueID() < best.UniqueID()) {
+			best = proj
+			minDistance = distance
+		}
+	}
+
+	if best != nil {
+		for i, destroyed := range p.gameState.destroyedFireProjectiles {
+			if destroyed.projectile == best {
+				p.gameState.destroyedFireProjectiles = append(p.gameState.destroyedFireProjectiles[:i], p.gameState.destroyedFireProjectiles[i+1:]...)
+
+				break
+			}
+		}
+	}
+
+	return best
+}
+
+// pruneDestroyedFireProjectiles forgets destroyed fire grenades that are too old to still start an inferno (one second).
+func (p *parser) pruneDestroyedFireProjectiles() {
+	maxAge := int(common.TickRateOrDefault(p.TickRate()))
+
+	recent := p.gameState.destroyedFireProjectiles[:0]
+
+	for _, destroyed := range p.gameState.destroyedFireProjectiles {
+		if p.gameState.ingameTick-destroyed.tick <= maxAge {
+			recent = append(recent, destroyed)
+		}
+	}
+
+	p.gameState.destroyedFireProjectiles = recent
+}
+
+func isFireGrenadeProjectile(proj *common.GrenadeProjectile) bool {
+	if proj.WeaponInstance == nil {
+		return false
+	}
+
+	return proj.WeaponInstance.Type == common.EqMolotov || proj.WeaponInstance.Type == common.EqIncendiary
 }
 
 func (p *parser) bindNewSmoke(entity st.Entity) {
@@ -1433,7 +1824,8 @@ func (p *parser) bindNewSmoke(entity st.Entity) {
 			return
 		}
 
-		if val.BoolVal() {
+		if val.BoolVal() && !smk.IsActive && smk.ExpiredTick < 0 {
+			smk.IsActive = true
 			smk.ActivationTick = p.demoInfoProvider.IngameTick()
 
 			p.eventDispatcher.Dispatch(events.FakeSmokeStart{
@@ -1450,17 +1842,30 @@ func (p *parser) bindNewSmoke(entity st.Entity) {
 	})
 }
 
+// disperseSmokes records an HE grenade explosion at the given position in all active smokes that it clears a hole in.
+func (p *parser) disperseSmokes(pos r3.Vector) {
+	for _, smk := range p.gameState.smokes {
+		if smk.IsActive && pos.Sub(smk.Position()).Norm() <= smk.Radius()+common.SmokeDispersalRadius {
+			smk.DispersalTick = p.gameState.ingameTick
+			smk.DispersalPosition = pos
+		}
+	}
+}
+
 func (p *parser) smokeExpired(smk *common.Smoke) {
 	if _, exists := p.gameState.smokes[smk.Entity.ID()]; !exists {
 		return
 	}
 
+	smk.IsActive = false
+	smk.ExpiredTick = p.gameState.ingameTick
+
 	delete(p.gameState.smokes, smk.Entity.ID())
 }
 
 func (p *parser) bindDefuseKit(entity st.Entity) {
 	dk := common.NewEquipment(common.EqDefuseKit, p.demoInfoProvider)
-	dk.Entity = entity
+	dk.AttachEntity(entity)
 	dk.EntityId = entity.ID()
 
 	entity.OnCreateFinished(func() {
@@ -1766,21 +2171,100 @@ func (p *parser) bindGameRules() {
 func (p *parser) bindHostages() {
 	p.stParser.ServerClasses().FindByName("CHostage").OnEntityCreated(func(entity st.Entity) {
 		entityID := entity.ID()
-		p.gameState.hostages[entityID] = common.NewHostage(p.demoInfoProvider, entity)
+		hostage := common.NewHostage(p.demoInfoProvider, entity)
+		hostage.ID = p.gameState.hostageID(hostage.SpawnPosition)
+		p.gameState.hostages[entityID] = hostage
 
 		entity.OnDestroy(func() {
 			delete(p.gameState.hostages, entityID)
 		})
 
-		var state common.HostageState
+		var (
+			state   common.HostageState
+			carrier *common.Player
+		)
+
 		entity.Property("m_nHostageState").OnUpdate(func(val st.PropertyValue) {
 			oldState := state
 			state = common.HostageState(val.Int())
 			if oldState != state {
 				p.eventDispatcher.Dispatch(events.HostageStateChanged{OldState: oldState, NewState: state, Hostage: p.gameState.hostages[entityID]})
+
+				// The grabber / leader may only be updated later in the same frame
+				newState := state
+				p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+					carrier = p.hostageStateChanged(hostage, oldState, newState, carrier)
+				})
 			}
 		})
 	})
+
+	// Not available on maps without hostages
+	if rescueZoneClass := p.stParser.ServerClasses().FindByName("CHostageRescueZone"); rescueZoneClass != nil {
+		rescueZoneClass.OnEntityCreated(func(entity st.Entity) {
+			zone := new(boundingBoxInformation)
+			p.gameState.hostageRescueZones[entity.ID()] = zone
+
+			// m_vecMins / m_vecMaxs are used as world coordinates, the same way as for bombsite triggers (see bindBombSites())
+			entity.BindProperty("m_vecMins", &zone.min, st.ValTypeVector)
+			entity.BindProperty("m_vecMaxs", &zone.max, st.ValTypeVector)
+
+			entity.OnDestroy(func() {
+				delete(p.gameState.hostageRescueZones, entity.ID())
+			})
+		})
+	}
+}
+
+// hostageStateChanged dispatches pickup, drop & rescue events for hostages and returns the new carrier.
+func (p *parser) hostageStateChanged(hostage *common.Hostage, oldState, newState common.HostageState, carrier *common.Player) *common.Player {
+	// Hostages are dropped before they are rescued, so being dropped still counts as being held
+	isHeld := func(state common.HostageState) bool {
+		return state.IsCarried() || state == common.HostageStateGettingDropped
+	}
+
+	if oldState == common.HostageStateRescued {
+		hostage.RescueTick = -1
+		hostage.RescuedBy = nil
+	}
+
+	switch {
+	case newState.IsCarried() && !isHeld(oldState):
+		carrier = hostage.Leader()
+
+		p.eventDispatcher.Dispatch(events.HostagePickup{
+			Player:  carrier,
+			Hostage: hostage,
+		})
+
+	case newState == common.HostageStateRescued:
+		if carrier == nil {
+			carrier = hostage.Leader()
+		}
+
+		hostage.RescueTick = p.gameState.ingameTick
+		hostage.RescuedBy = carrier
+		p.gameState.addHostageRescue(carrier)
+
+		if !p.disableMimicSource1GameEvents {
+			p.eventDispatcher.Dispatch(events.HostageRescued{
+				Player:  carrier,
+				Hostage: hostage,
+			})
+		}
+
+		return nil
+
+	case isHeld(oldState) && !isHeld(newState):
+		p.eventDispatcher.Dispatch(events.HostageDrop{
+			Player:  carrier,
+			Hostage: hostage,
+		})
+
+		return nil
+	}
+
+	return carrier
 }
 
 func getDistanceBetweenVectors(vectorA r3.Vector, vectorB r3.Vector) float64 {
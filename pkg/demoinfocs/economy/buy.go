@@ -0,0 +1,46 @@
+package economy
+
+// BuyType is the type for the various BuyTypeXYZ constants.
+type BuyType byte
+
+// BuyType constants classify how much a team or player invested into a round.
+const (
+	BuyTypeUnknown BuyType = iota
+	BuyTypePistol          // First round of a half in regulation time
+	BuyTypeEco             // Saving money, little to no equipment bought
+	BuyTypeForce           // Spending (almost) all money without being able to afford a full buy
+	BuyTypeHalf            // Partial buy while keeping money for the next round
+	BuyTypeFull            // Rifles / heavy weapons with armor and utility
+)
+
+var strBuyTypes = map[BuyType]string{
+	BuyTypeUnknown: "Unknown",
+	BuyTypePistol:  "Pistol",
+	BuyTypeEco:     "Eco",
+	BuyTypeForce:   "Force",
+	BuyTypeHalf:    "Half",
+	BuyTypeFull:    "Full",
+}
+
+func (bt BuyType) String() string {
+	if _, exists := strBuyTypes[bt]; !exists {
+		return "Unknown-BuyType"
+	}
+
+	return strBuyTypes[bt]
+}
+
+// Classify returns the buy type for the given average equipment value and average remaining money per player
+// at the end of the freeze time. Pistol rounds are not detected here, see Config.IsPistolRound().
+func (cfg Config) Classify(equipmentValue, remainingMoney int) BuyType {
+	switch {
+	case equipmentValue >= cfg.FullBuyMinValue:
+		return BuyTypeFull
+	case equipmentValue < cfg.EcoMaxValue:
+		return BuyTypeEco
+	case remainingMoney < cfg.ForceBuyMaxRemainingMoney:
+		return BuyTypeForce
+	default:
+		return BuyTypeHalf
+	}
+}
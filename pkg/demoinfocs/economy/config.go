@@ -0,0 +1,89 @@
+package economy
+
+import (
+	"strconv"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// Config contains the thresholds used to classify buys and compute loss bonuses.
+// Values are per player, e.g. an EcoMaxValue of 1000 means a team of five with an equipment value below $5000 is on an eco.
+type Config struct {
+	EcoMaxValue               int // Average equipment value below which a buy is an eco
+	FullBuyMinValue           int // Average equipment value from which on a buy is a full buy
+	ForceBuyMaxRemainingMoney int // Average money left after the freeze time below which a buy that isn't a full buy is a force buy
+
+	MaxRounds int // Number of rounds in regulation time (mp_maxrounds), used to find pistol rounds
+
+	LossBonus            int // Money for the first loss (cash_team_loser_bonus)
+	LossBonusIncrement   int // Additional money for each consecutive loss (cash_team_loser_bonus_consecutive_rounds)
+	MaxConsecutiveLosses int // Number of consecutive losses after which the loss bonus doesn't increase anymore (mp_consecutive_loss_max + 1)
+}
+
+// ConfigMR12 is the default configuration for CS2 matches with 24 rounds in regulation time.
+var ConfigMR12 = Config{
+	EcoMaxValue:               1000,
+	FullBuyMinValue:           4000,
+	ForceBuyMaxRemainingMoney: 1000,
+	MaxRounds:                 24,
+	LossBonus:                 1400,
+	LossBonusIncrement:        500,
+	MaxConsecutiveLosses:      5,
+}
+
+// ConfigMR15 is the default configuration for (CS:GO) matches with 30 rounds in regulation time.
+var ConfigMR15 = Config{
+	EcoMaxValue:               1000,
+	FullBuyMinValue:           4000,
+	ForceBuyMaxRemainingMoney: 1000,
+	MaxRounds:                 30,
+	LossBonus:                 1400,
+	LossBonusIncrement:        500,
+	MaxConsecutiveLosses:      5,
+}
+
+// WithConVars returns a copy of the config with the values overridden by the given convars (see GameRules().ConVars()).
+// Convars that aren't set or can't be parsed are ignored.
+func (cfg Config) WithConVars(conVars map[string]string) Config {
+	for name, field := range map[string]*int{
+		"mp_maxrounds":                             &cfg.MaxRounds,
+		"cash_team_loser_bonus":                    &cfg.LossBonus,
+		"cash_team_loser_bonus_consecutive_rounds": &cfg.LossBonusIncrement,
+	} {
+		if v, err := strconv.Atoi(conVars[name]); err == nil && v > 0 {
+			*field = v
+		}
+	}
+
+	// mp_consecutive_loss_max is the number of loss bonus increments, the first loss doesn't count as one
+	if v, err := strconv.Atoi(conVars["mp_consecutive_loss_max"]); err == nil && v >= 0 {
+		cfg.MaxConsecutiveLosses = v + 1
+	}
+
+	return cfg
+}
+
+// IsPistolRound returns true if the round is the first one of a half in regulation time.
+// Overtime halves are not pistol rounds as teams start with mp_overtime_startmoney.
+func (cfg Config) IsPistolRound(round *common.Round) bool {
+	if round.OvertimeNumber > 0 {
+		return false
+	}
+
+	return round.Number == 1 || round.Number == cfg.MaxRounds/2+1
+}
+
+// LossBonusFor returns the money each player of a team receives for losing a round
+// after the given number of consecutive losses (including the lost round).
+func (cfg Config) LossBonusFor(consecutiveLosses int) int {
+	if consecutiveLosses <= 0 {
+		return 0
+	}
+
+	n := consecutiveLosses
+	if cfg.MaxConsecutiveLosses > 0 {
+		n = min(n, cfg.MaxConsecutiveLosses)
+	}
+
+	return cfg.LossBonus + (n-1)*cfg.LossBonusIncrement
+}
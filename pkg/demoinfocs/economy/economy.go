@@ -0,0 +1,218 @@
+// Package economy classifies the buys of both teams in every round and keeps track of their loss bonus.
+//
+// A Tracker attaches to a parser and takes a snapshot of each team's money and equipment at the end of the freeze time.
+// Buys are classified as pistol, eco, force, half or full buy based on the average equipment value and remaining money
+// per player, see Config for the thresholds.
+//
+// Example:
+//
+//	tracker := economy.NewTracker(parser, economy.ConfigMR12)
+//	parser.ParseToEnd()
+//
+//	for _, round := range tracker.Rounds() {
+//		fmt.Printf("round %d: CT %s vs T %s\n", round.Number, round.CT.BuyType, round.T.BuyType)
+//	}
+//
+// The loss bonus follows the CS:GO / CS2 rules: each loss increases the loss count (up to Config.MaxConsecutiveLosses),
+// each win decreases it by one. The count is reset to one at the start of every half, so losing a pistol round pays
+// $1900 and the maximum of $3400 is reached after four losses in a row (with the default convars).
+package economy
+
+import (
+	"sort"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// Tracker collects the economy of both teams during parsing.
+type Tracker struct {
+	parser demoinfocs.Parser
+	config Config
+
+	rounds []*RoundEconomy
+}
+
+// NewTracker creates a Tracker and registers its event handlers on the parser.
+// The values of the config are overridden by the mp_ / cash_ convars of the demo, see Config.WithConVars().
+func NewTracker(parser demoinfocs.Parser, config Config) *Tracker {
+	t := &Tracker{
+		parser: parser,
+		config: config,
+	}
+
+	parser.RegisterEventHandler(t.roundStart)
+	parser.RegisterEventHandler(t.roundFreezetimeEnd)
+	parser.RegisterEventHandler(t.roundEnd)
+
+	return t
+}
+
+// Rounds returns the economy of all rounds so far.
+func (t *Tracker) Rounds() []*RoundEconomy {
+	return t.rounds
+}
+
+// Round returns the economy of the round with the given number or nil if it's unknown.
+func (t *Tracker) Round(number int) *RoundEconomy {
+	for _, round := range t.rounds {
+		if round.Number == number {
+			return round
+		}
+	}
+
+	return nil
+}
+
+// Timeline returns the economy of the team with the given clan name in every round, regardless of the side it played on.
+// Rounds in which no team with this name played are skipped.
+func (t *Tracker) Timeline(clanName string) []*TeamEconomy {
+	var timeline []*TeamEconomy
+
+	for _, round := range t.rounds {
+		switch clanName {
+		case round.CT.ClanName:
+			timeline = append(timeline, &round.CT)
+		case round.T.ClanName:
+			timeline = append(timeline, &round.T)
+		}
+	}
+
+	return timeline
+}
+
+// SideTimeline returns the economy of the given side in every round.
+func (t *Tracker) SideTimeline(team common.Team) []*TeamEconomy {
+	var timeline []*TeamEconomy
+
+	for _, round := range t.rounds {
+		if te := round.Team(team); te != nil {
+			timeline = append(timeline, te)
+		}
+	}
+
+	return timeline
+}
+
+// Config returns the config with the convars of the demo applied.
+func (t *Tracker) Config() Config {
+	return t.config.WithConVars(t.parser.GameState().Rules().ConVars())
+}
+
+func (t *Tracker) currentRound() *RoundEconomy {
+	round := t.parser.GameState().CurrentRound()
+	if round == nil || len(t.rounds) == 0 {
+		return nil
+	}
+
+	last := t.rounds[len(t.rounds)-1]
+	if last.Number != round.Number {
+		return nil
+	}
+
+	return last
+}
+
+// previousRound returns the round before the current one if it's in the same half.
+func (t *Tracker) previousRound(half int) *RoundEconomy {
+	if len(t.rounds) < 2 {
+		return nil
+	}
+
+	prev := t.rounds[len(t.rounds)-2]
+	if prev.Half != half {
+		return nil
+	}
+
+	return prev
+}
+
+func (t *Tracker) roundStart(events.RoundStart) {
+	round := t.parser.GameState().CurrentRound()
+	if round == nil || round.StartTick != t.parser.GameState().IngameTick() {
+		// no new round was started, e.g. during the warmup
+		return
+	}
+
+	// mp_restartgame or a restored round backup
+	for len(t.rounds) > 0 && t.rounds[len(t.rounds)-1].Number >= round.Number {
+		t.rounds = t.rounds[:len(t.rounds)-1]
+	}
+
+	t.rounds = append(t.rounds, &RoundEconomy{
+		Number: round.Number,
+		CT:     TeamEconomy{Team: common.TeamCounterTerrorists},
+		T:      TeamEconomy{Team: common.TeamTerrorists},
+	})
+}
+
+func (t *Tracker) roundFreezetimeEnd(events.RoundFreezetimeEnd) {
+	re := t.currentRound()
+	if re == nil {
+		return
+	}
+
+	round := t.parser.GameState().CurrentRound()
+	cfg := t.Config()
+
+	re.Half = round.Half
+	re.Pistol = cfg.IsPistolRound(round)
+
+	prev := t.previousRound(re.Half)
+
+	for _, team := range []common.Team{common.TeamCounterTerrorists, common.TeamTerrorists} {
+		te := re.Team(team)
+		te.ClanName = round.Team(team).ClanName
+		te.Players = te.Players[:0]
+		te.EquipmentValue = 0
+		te.MoneySpent = 0
+		te.Money = 0
+
+		members := t.parser.GameState().Team(team).Members()
+		sort.Slice(members, func(i, j int) bool {
+			return members[i].UserID < members[j].UserID
+		})
+
+		for _, pl := range members {
+			if !pl.IsConnected {
+				continue
+			}
+
+			pe := newPlayerEconomy(pl, cfg, re.Pistol)
+
+			te.Players = append(te.Players, pe)
+			te.EquipmentValue += pe.EquipmentValue
+			te.MoneySpent += pe.MoneySpent
+			te.Money += pe.Money
+		}
+
+		// the game starts every half with one loss, so losing the pistol round already pays the second loss bonus
+		te.ConsecutiveLosses = 1
+		if prev != nil && !re.Pistol {
+			te.ConsecutiveLosses = prev.Team(team).consecutiveLossesAfter(prev.Winner, cfg)
+		}
+
+		te.LossBonus = cfg.LossBonusFor(te.ConsecutiveLosses + 1)
+
+		switch {
+		case re.Pistol:
+			te.BuyType = BuyTypePistol
+		case len(te.Players) == 0:
+			te.BuyType = BuyTypeUnknown
+		default:
+			te.BuyType = cfg.Classify(te.AverageEquipmentValue(), te.AverageMoney())
+		}
+	}
+}
+
+func (t *Tracker) roundEnd(e events.RoundEnd) {
+	re := t.currentRound()
+	if re == nil || re.Winner != common.TeamUnassigned {
+		return
+	}
+
+	re.Winner = e.Winner
+	re.CT.Won = e.Winner == common.TeamCounterTerrorists
+	re.T.Won = e.Winner == common.TeamTerrorists
+}
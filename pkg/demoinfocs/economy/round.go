@@ -0,0 +1,125 @@
+package economy
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// RoundEconomy contains the economy of both teams during a single round.
+type RoundEconomy struct {
+	Number int
+	Half   int
+	Pistol bool
+	Winner common.Team // TeamUnassigned while the round is in progress
+
+	CT TeamEconomy
+	T  TeamEconomy
+}
+
+// Team returns the economy of the given side or nil if team is neither T nor CT.
+func (re *RoundEconomy) Team(team common.Team) *TeamEconomy {
+	switch team {
+	case common.TeamCounterTerrorists:
+		return &re.CT
+	case common.TeamTerrorists:
+		return &re.T
+	default:
+		return nil
+	}
+}
+
+// TeamEconomy contains the economy of a team during a single round.
+// Money and equipment values are the ones at the end of the freeze time.
+type TeamEconomy struct {
+	Team     common.Team
+	ClanName string
+	BuyType  BuyType         // BuyTypeUnknown until the end of the freeze time
+	Players  []PlayerEconomy // Ordered by UserID
+
+	EquipmentValue int
+	MoneySpent     int // During the freeze time
+	Money          int // Remaining money
+
+	ConsecutiveLosses int  // Losses before this round that count towards the loss bonus, every half starts at 1
+	LossBonus         int  // Money each player receives if the team loses this round
+	Won               bool // Set at the end of the round
+}
+
+// AverageEquipmentValue returns the equipment value per player.
+func (te *TeamEconomy) AverageEquipmentValue() int {
+	return te.perPlayer(te.EquipmentValue)
+}
+
+// AverageMoney returns the remaining money per player.
+func (te *TeamEconomy) AverageMoney() int {
+	return te.perPlayer(te.Money)
+}
+
+func (te *TeamEconomy) perPlayer(n int) int {
+	if len(te.Players) == 0 {
+		return 0
+	}
+
+	return n / len(te.Players)
+}
+
+// consecutiveLossesAfter returns the loss count for the next round of the same half.
+// A loss increases the count, a win decreases it by one.
+// The count stops at MaxConsecutiveLosses - 1 as the next loss already pays the maximum loss bonus,
+// so a win after many losses reduces the bonus right away, like in the game.
+func (te *TeamEconomy) consecutiveLossesAfter(winner common.Team, cfg Config) int {
+	switch winner {
+	case te.Team:
+		return max(te.ConsecutiveLosses-1, 0)
+	case common.TeamTerrorists, common.TeamCounterTerrorists:
+		if cfg.MaxConsecutiveLosses > 0 {
+			return min(te.ConsecutiveLosses+1, max(cfg.MaxConsecutiveLosses-1, 0))
+		}
+
+		return te.ConsecutiveLosses + 1
+	default:
+		return te.ConsecutiveLosses
+	}
+}
+
+// PlayerEconomy contains the economy of a single player at the end of the freeze time.
+type PlayerEconomy struct {
+	Player         *common.Player
+	BuyType        BuyType
+	EquipmentValue int
+	MoneySpent     int
+	Money          int  // Remaining money
+	HasPrimary     bool // SMG, rifle or heavy weapon
+	HasArmor       bool
+	HasHelmet      bool
+	HasDefuseKit   bool
+	Grenades       int
+}
+
+func newPlayerEconomy(pl *common.Player, cfg Config, pistol bool) PlayerEconomy {
+	pe := PlayerEconomy{
+		Player:         pl,
+		EquipmentValue: pl.EquipmentValueFreezeTimeEnd(),
+		MoneySpent:     pl.MoneySpentThisRound(),
+		Money:          pl.Money(),
+		HasArmor:       pl.Armor() > 0,
+		HasHelmet:      pl.HasHelmet(),
+		HasDefuseKit:   pl.HasDefuseKit(),
+	}
+
+	for _, w := range pl.Weapons() {
+		switch w.Class() {
+		case common.EqClassSMG, common.EqClassRifle, common.EqClassHeavy:
+			pe.HasPrimary = true
+		case common.EqClassGrenade:
+			pe.Grenades++
+		}
+	}
+
+	if pistol {
+		pe.BuyType = BuyTypePistol
+	} else {
+		pe.BuyType = cfg.Classify(pe.EquipmentValue, pe.Money)
+	}
+
+	return pe
+}
@@ -0,0 +1,278 @@
+// Package engagement detects encounters between enemies and measures how fast players react in them.
+//
+// An engagement starts when two enemies can see each other (mutual radar spotting, see Player.IsSpottedBy())
+// and ends when one of them dies, the round ends or they lose sight of each other for longer than Config.DisengageTimeout.
+// For both players the first shot, the first hit on the opponent and a kill are recorded,
+// as well as whether the player was already aiming at the opponent when the engagement started (pre-aim).
+//
+// Example:
+//
+//	analyzer := engagement.NewAnalyzer(parser, engagement.DefaultConfig)
+//	parser.ParseToEnd()
+//
+//	for _, e := range analyzer.Engagements() {
+//		if winner := e.Winner(); winner != nil {
+//			ttk, _ := e.TimeToKill(winner)
+//			fmt.Printf("%s killed %s after %s\n", winner, e.Opponent(winner), ttk)
+//		}
+//	}
+package engagement
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// Config contains the parameters of the engagement detection.
+type Config struct {
+	PreAimMaxDistance float64       // Maximum distance in world units between the view ray and the opponent to count as pre-aimed
+	DisengageTimeout  time.Duration // Time the players may lose sight of each other before the engagement ends
+}
+
+// DefaultConfig is the default configuration used for engagement detection.
+var DefaultConfig = Config{
+	PreAimMaxDistance: 20,
+	DisengageTimeout:  2 * time.Second,
+}
+
+type pairKey struct {
+	a, b *common.Player
+}
+
+// Analyzer detects engagements during parsing.
+type Analyzer struct {
+	parser demoinfocs.Parser
+	config Config
+
+	engagements []*Engagement
+	active      map[pairKey]*Engagement
+}
+
+// NewAnalyzer creates an Analyzer and registers its event handlers on the parser.
+func NewAnalyzer(parser demoinfocs.Parser, config Config) *Analyzer {
+	a := &Analyzer{
+		parser: parser,
+		config: config,
+		active: make(map[pairKey]*Engagement),
+	}
+
+	parser.RegisterEventHandler(a.playerSpottersChanged)
+	parser.RegisterEventHandler(a.weaponFire)
+	parser.RegisterEventHandler(a.playerHurt)
+	parser.RegisterEventHandler(a.kill)
+	parser.RegisterEventHandler(a.roundStart)
+	parser.RegisterEventHandler(a.roundEnd)
+	parser.RegisterEventHandler(a.frameDone)
+
+	return a
+}
+
+// Engagements returns all engagements so far, in order of their start.
+func (a *Analyzer) Engagements() []*Engagement {
+	return a.engagements
+}
+
+// EngagementsOf returns all engagements the given player took part in, in order of their start.
+func (a *Analyzer) EngagementsOf(pl *common.Player) []*Engagement {
+	var res []*Engagement
+
+	for _, e := range a.engagements {
+		if e.Participant(pl) != nil {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+// Active returns the engagement between the two players that is currently in progress or nil if there is none.
+func (a *Analyzer) Active(pl, other *common.Player) *Engagement {
+	return a.active[keyOf(pl, other)]
+}
+
+func keyOf(pl, other *common.Player) pairKey {
+	if other.UserID < pl.UserID {
+		return pairKey{a: other, b: pl}
+	}
+
+	return pairKey{a: pl, b: other}
+}
+
+func (a *Analyzer) tickRate() float64 {
+	return common.TickRateOrDefault(a.parser.TickRate())
+}
+
+func isCombatant(pl *common.Player) bool {
+	return pl != nil && pl.IsAlive() && (pl.Team == common.TeamTerrorists || pl.Team == common.TeamCounterTerrorists)
+}
+
+func (a *Analyzer) playerSpottersChanged(e events.PlayerSpottersChanged) {
+	spotted := e.Spotted
+	if !isCombatant(spotted) {
+		return
+	}
+
+	enemies := a.parser.GameState().Participants().Playing()
+	sort.Slice(enemies, func(i, j int) bool {
+		return enemies[i].UserID < enemies[j].UserID
+	})
+
+	tick := a.parser.GameState().IngameTick()
+
+	for _, enemy := range enemies {
+		if enemy.Team == spotted.Team || !isCombatant(enemy) {
+			continue
+		}
+
+		key := keyOf(spotted, enemy)
+		eng := a.active[key]
+		mutual := spotted.IsSpottedBy(enemy) && enemy.IsSpottedBy(spotted)
+
+		switch {
+		case mutual && eng == nil:
+			a.start(key, tick)
+		case mutual:
+			eng.lostSightTick = -1
+		case eng != nil && eng.lostSightTick == -1:
+			eng.lostSightTick = tick
+		}
+	}
+}
+
+func (a *Analyzer) start(key pairKey, tick int) {
+	eng := &Engagement{
+		StartTick:     tick,
+		EndTick:       -1,
+		A:             a.newParticipant(key.a, key.b),
+		B:             a.newParticipant(key.b, key.a),
+		lostSightTick: -1,
+		tickRate:      a.tickRate(),
+	}
+
+	if round := a.parser.GameState().CurrentRound(); round != nil {
+		eng.Round = round.Number
+	}
+
+	a.engagements = append(a.engagements, eng)
+	a.active[key] = eng
+}
+
+func (a *Analyzer) newParticipant(pl, opponent *common.Player) Participant {
+	distance := pl.ViewRayDistanceTo(opponent)
+
+	return Participant{
+		Player:         pl,
+		FirstShotTick:  -1,
+		FirstHitTick:   -1,
+		PreAimDistance: distance,
+		PreAimed:       distance <= a.config.PreAimMaxDistance,
+		SpotAngle:      pl.ViewAngleTo(opponent.PositionEyes()),
+	}
+}
+
+// endAll ends all active engagements matching the filter.
+func (a *Analyzer) endAll(outcome Outcome, filter func(*Engagement) bool) {
+	tick := a.parser.GameState().IngameTick()
+
+	for key, eng := range a.active {
+		if filter(eng) {
+			eng.end(tick, outcome)
+			delete(a.active, key)
+		}
+	}
+}
+
+func (a *Analyzer) weaponFire(e events.WeaponFire) {
+	if e.Shooter == nil || e.Weapon == nil {
+		return
+	}
+
+	switch e.Weapon.Class() {
+	case common.EqClassPistols, common.EqClassSMG, common.EqClassHeavy, common.EqClassRifle:
+	default:
+		return
+	}
+
+	// the shot is only attributed to the engagement with the opponent closest to the shooter's view ray,
+	// otherwise a shot at one enemy would count as reaction to all others
+	var (
+		target    *Engagement
+		bestAngle float64
+	)
+
+	for _, eng := range a.active {
+		opponent := eng.Opponent(e.Shooter)
+		if opponent == nil {
+			continue
+		}
+
+		angle := e.Shooter.ViewAngleTo(opponent.PositionEyes())
+		if target == nil || angle < bestAngle || (angle == bestAngle && opponent.UserID < target.Opponent(e.Shooter).UserID) {
+			target, bestAngle = eng, angle
+		}
+	}
+
+	if target == nil {
+		return
+	}
+
+	if p := target.Participant(e.Shooter); p.FirstShotTick == -1 {
+		p.FirstShotTick = a.parser.GameState().IngameTick()
+	}
+}
+
+func (a *Analyzer) playerHurt(e events.PlayerHurt) {
+	if e.Player == nil || e.Attacker == nil || e.Player == e.Attacker {
+		return
+	}
+
+	eng := a.active[keyOf(e.Attacker, e.Player)]
+	if eng == nil {
+		return
+	}
+
+	if p := eng.Participant(e.Attacker); p.FirstHitTick == -1 {
+		p.FirstHitTick = a.parser.GameState().IngameTick()
+	}
+}
+
+func (a *Analyzer) kill(e events.Kill) {
+	if e.Victim == nil {
+		return
+	}
+
+	if e.Killer != nil && e.Killer != e.Victim {
+		key := keyOf(e.Killer, e.Victim)
+		if eng := a.active[key]; eng != nil {
+			eng.Participant(e.Killer).Killed = true
+			eng.end(a.parser.GameState().IngameTick(), OutcomeKill)
+			delete(a.active, key)
+		}
+	}
+
+	a.endAll(OutcomeOtherDeath, func(eng *Engagement) bool {
+		return eng.Participant(e.Victim) != nil
+	})
+}
+
+func (a *Analyzer) roundStart(events.RoundStart) {
+	a.endAll(OutcomeRoundEnd, func(*Engagement) bool { return true })
+}
+
+func (a *Analyzer) roundEnd(events.RoundEnd) {
+	a.endAll(OutcomeRoundEnd, func(*Engagement) bool { return true })
+}
+
+func (a *Analyzer) frameDone(events.FrameDone) {
+	tick := a.parser.GameState().IngameTick()
+	timeout := int(math.Round(a.config.DisengageTimeout.Seconds() * a.tickRate()))
+
+	a.endAll(OutcomeDisengaged, func(eng *Engagement) bool {
+		return eng.lostSightTick != -1 && tick-eng.lostSightTick > timeout
+	})
+}
@@ -0,0 +1,150 @@
+package engagement
+
+import (
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// Outcome is the type for the various OutcomeXYZ constants.
+type Outcome byte
+
+// Outcome constants give information about how an engagement ended.
+const (
+	OutcomeInProgress Outcome = iota
+	OutcomeKill               // One of the players killed the other, see Engagement.Winner()
+	OutcomeOtherDeath         // One of the players was killed by someone else (or died otherwise)
+	OutcomeDisengaged         // The players lost sight of each other for longer than Config.DisengageTimeout
+	OutcomeRoundEnd           // The round ended while both players were alive
+)
+
+var strOutcomes = map[Outcome]string{
+	OutcomeInProgress: "InProgress",
+	OutcomeKill:       "Kill",
+	OutcomeOtherDeath: "OtherDeath",
+	OutcomeDisengaged: "Disengaged",
+	OutcomeRoundEnd:   "RoundEnd",
+}
+
+func (o Outcome) String() string {
+	if _, exists := strOutcomes[o]; !exists {
+		return "Unknown-Outcome"
+	}
+
+	return strOutcomes[o]
+}
+
+// Engagement is an encounter of two enemies, starting when they can see each other (see Player.IsSpottedBy()).
+//
+// Ticks of things that didn't happen (yet) are -1.
+type Engagement struct {
+	Round     int // 0 if the engagement happened outside of a round (e.g. during the warmup)
+	StartTick int // First tick of mutual visibility
+	EndTick   int
+	Outcome   Outcome
+
+	// The players of the engagement, A is the one with the lower UserID.
+	A Participant
+	B Participant
+
+	lostSightTick int // Tick at which the players lost sight of each other, -1 while they can see each other
+	tickRate      float64
+}
+
+// Participant contains the actions of one player during an engagement.
+type Participant struct {
+	Player         *common.Player
+	FirstShotTick  int // Shots count towards the engagement whose opponent was closest to the player's crosshair
+	FirstHitTick   int
+	Killed         bool    // True if the player killed the opponent
+	PreAimDistance float64 // Distance between the player's view ray and the opponent at the start, see Player.ViewRayDistanceTo()
+	PreAimed       bool    // True if PreAimDistance was within Config.PreAimMaxDistance
+	SpotAngle      float64 // Angle in degrees between the player's view direction and the opponent's head at the start, see Player.ViewAngleTo()
+}
+
+// IsOver returns true if the engagement has ended.
+func (e *Engagement) IsOver() bool {
+	return e.Outcome != OutcomeInProgress
+}
+
+// Participant returns the actions of the given player or nil if they didn't take part in the engagement.
+func (e *Engagement) Participant(pl *common.Player) *Participant {
+	switch pl {
+	case e.A.Player:
+		return &e.A
+	case e.B.Player:
+		return &e.B
+	default:
+		return nil
+	}
+}
+
+// Opponent returns the opponent of the given player or nil if they didn't take part in the engagement.
+func (e *Engagement) Opponent(pl *common.Player) *common.Player {
+	switch pl {
+	case e.A.Player:
+		return e.B.Player
+	case e.B.Player:
+		return e.A.Player
+	default:
+		return nil
+	}
+}
+
+// Winner returns the player that killed the other one or nil if the engagement didn't end with a kill.
+func (e *Engagement) Winner() *common.Player {
+	switch {
+	case e.A.Killed:
+		return e.A.Player
+	case e.B.Killed:
+		return e.B.Player
+	default:
+		return nil
+	}
+}
+
+// TimeToFirstShot returns the time from the start of the engagement to the player's first shot.
+// The second return value is false if the player didn't shoot.
+func (e *Engagement) TimeToFirstShot(pl *common.Player) (time.Duration, bool) {
+	p := e.Participant(pl)
+	if p == nil {
+		return 0, false
+	}
+
+	return e.timeSinceStart(p.FirstShotTick)
+}
+
+// TimeToFirstHit returns the time from the start of the engagement to the player's first hit on the opponent.
+// The second return value is false if the player didn't hit the opponent.
+func (e *Engagement) TimeToFirstHit(pl *common.Player) (time.Duration, bool) {
+	p := e.Participant(pl)
+	if p == nil {
+		return 0, false
+	}
+
+	return e.timeSinceStart(p.FirstHitTick)
+}
+
+// TimeToKill returns the time from the start of the engagement until the player killed the opponent.
+// The second return value is false if the player didn't kill the opponent.
+func (e *Engagement) TimeToKill(pl *common.Player) (time.Duration, bool) {
+	p := e.Participant(pl)
+	if p == nil || !p.Killed {
+		return 0, false
+	}
+
+	return e.timeSinceStart(e.EndTick)
+}
+
+func (e *Engagement) timeSinceStart(tick int) (time.Duration, bool) {
+	if tick < 0 {
+		return 0, false
+	}
+
+	return time.Duration(float64(tick-e.StartTick) / e.tickRate * float64(time.Second)), true
+}
+
+func (e *Engagement) end(tick int, outcome Outcome) {
+	e.EndTick = tick
+	e.Outcome = outcome
+}
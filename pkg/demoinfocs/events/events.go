@@ -6,6 +6,7 @@
 package events
 
 import (
+	"strings"
 	"time"
 
 	"github.com/golang/geo/r3"
@@ -61,31 +62,31 @@ type RoundFreezetimeChanged struct {
 // RoundEndReason is the type for the various RoundEndReasonXYZ constants.
 //
 // See RoundEnd.
-type RoundEndReason byte
+type RoundEndReason = common.RoundEndReason
 
 // RoundEndReason constants give information about why a round ended (Bomb defused, exploded etc.).
 const (
-	RoundEndReasonStillInProgress      RoundEndReason = 0
-	RoundEndReasonTargetBombed         RoundEndReason = 1
-	RoundEndReasonVIPEscaped           RoundEndReason = 2
-	RoundEndReasonVIPKilled            RoundEndReason = 3
-	RoundEndReasonTerroristsEscaped    RoundEndReason = 4
-	RoundEndReasonCTStoppedEscape      RoundEndReason = 5
-	RoundEndReasonTerroristsStopped    RoundEndReason = 6
-	RoundEndReasonBombDefused          RoundEndReason = 7
-	RoundEndReasonCTWin                RoundEndReason = 8
-	RoundEndReasonTerroristsWin        RoundEndReason = 9
-	RoundEndReasonDraw                 RoundEndReason = 10
-	RoundEndReasonHostagesRescued      RoundEndReason = 11
-	RoundEndReasonTargetSaved          RoundEndReason = 12
-	RoundEndReasonHostagesNotRescued   RoundEndReason = 13
-	RoundEndReasonTerroristsNotEscaped RoundEndReason = 14
-	RoundEndReasonVIPNotEscaped        RoundEndReason = 15
-	RoundEndReasonGameStart            RoundEndReason = 16
-	RoundEndReasonTerroristsSurrender  RoundEndReason = 17
-	RoundEndReasonCTSurrender          RoundEndReason = 18
-	RoundEndReasonTerroristsPlanted    RoundEndReason = 19
-	RoundEndReasonCTsReachedHostage    RoundEndReason = 20
+	RoundEndReasonStillInProgress      = common.RoundEndReasonStillInProgress
+	RoundEndReasonTargetBombed         = common.RoundEndReasonTargetBombed
+	RoundEndReasonVIPEscaped           = common.RoundEndReasonVIPEscaped
+	RoundEndReasonVIPKilled            = common.RoundEndReasonVIPKilled
+	RoundEndReasonTerroristsEscaped    = common.RoundEndReasonTerroristsEscaped
+	RoundEndReasonCTStoppedEscape      = common.RoundEndReasonCTStoppedEscape
+	RoundEndReasonTerroristsStopped    = common.RoundEndReasonTerroristsStopped
+	RoundEndReasonBombDefused          = common.RoundEndReasonBombDefused
+	RoundEndReasonCTWin                = common.RoundEndReasonCTWin
+	RoundEndReasonTerroristsWin        = common.RoundEndReasonTerroristsWin
+	RoundEndReasonDraw                 = common.RoundEndReasonDraw
+	RoundEndReasonHostagesRescued      = common.RoundEndReasonHostagesRescued
+	RoundEndReasonTargetSaved          = common.RoundEndReasonTargetSaved
+	RoundEndReasonHostagesNotRescued   = common.RoundEndReasonHostagesNotRescued
+	RoundEndReasonTerroristsNotEscaped = common.RoundEndReasonTerroristsNotEscaped
+	RoundEndReasonVIPNotEscaped        = common.RoundEndReasonVIPNotEscaped
+	RoundEndReasonGameStart            = common.RoundEndReasonGameStart
+	RoundEndReasonTerroristsSurrender  = common.RoundEndReasonTerroristsSurrender
+	RoundEndReasonCTSurrender          = common.RoundEndReasonCTSurrender
+	RoundEndReasonTerroristsPlanted    = common.RoundEndReasonTerroristsPlanted
+	RoundEndReasonCTsReachedHostage    = common.RoundEndReasonCTsReachedHostage
 )
 
 // RoundEnd signals that a round just finished.
@@ -218,6 +219,33 @@ func (k Kill) IsWallBang() bool {
 	return k.PenetratedObjects > 0
 }
 
+// TradeKill signals that a kill traded the death of a teammate.
+// A kill is a trade if the victim killed a teammate of the killer shortly before (see ParserConfig.TradeWindow).
+// It's dispatched after the Kill event of the trading kill, at the end of the frame.
+// If the victim killed multiple teammates of the killer within the window, one event is dispatched for each of them.
+type TradeKill struct {
+	Killer       *common.Player // The player that traded the death of their teammate
+	Victim       *common.Player // The player that got killed, TradedKill.Killer
+	TradedPlayer *common.Player // The teammate of Killer whose death was traded, TradedKill.Victim
+	Kill         *common.RoundKill
+	TradedKill   *common.RoundKill
+	Delay        time.Duration // Time between the traded death and the trade
+}
+
+// ClutchStart signals that a player became the last one alive on their team while enemies are still alive.
+// It's dispatched at the end of the frame in which the last teammate died or disconnected.
+type ClutchStart struct {
+	Player *common.Player // May be a bot, see Player.Controller()
+	Clutch *common.Clutch
+}
+
+// ClutchEnd signals that the round of a clutch situation has ended.
+// It's dispatched after the RoundEnd event, see Clutch.Won and Clutch.Saved for the outcome.
+type ClutchEnd struct {
+	Player *common.Player
+	Clutch *common.Clutch
+}
+
 // BotTakenOver signals that a player took over a bot.
 type BotTakenOver struct {
 	Taker *common.Player
@@ -234,15 +262,22 @@ type FakeWeaponFire struct {
 	Weapon  *common.Equipment
 }
 
-// WeaponReload signals that a player started to reload his weapon.
+// WeaponReloadBegin signals that a player started to reload his weapon.
 type WeaponReloadBegin struct {
-	Player *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
+	Player         *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
+	Weapon         *common.Equipment
+	AmmoInMagazine int // Ammo in the magazine when the reload started
+	AmmoReserve    int // Reserve ammo when the reload started
 }
 
-// WeaponReload signals that a player finished reloading his weapon.
+// WeaponReloadEnd signals that a player finished reloading his weapon.
+// Success is false if the reload was cancelled (e.g. by switching or dropping the weapon) before the magazine was refilled.
 type WeaponReloadEnd struct {
-	Player  *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
-	Success bool
+	Player     *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
+	Weapon     *common.Equipment
+	Success    bool
+	AmmoBefore int // Ammo in the magazine when the reload started
+	AmmoAfter  int // Ammo in the magazine when the reload ended
 }
 
 type ItemStateUpdate struct {
@@ -399,7 +434,8 @@ type GrenadeProjectileThrow struct {
 // GrenadeProjectileDestroy signals that a nade entity is being destroyed (i.e. it detonated / expired).
 // This is different from the other Grenade events because it's sent out when the projectile entity is destroyed.
 //
-// Mainly useful for getting the full trajectory of the projectile.
+// Mainly useful for getting the full trajectory of the projectile (see Projectile.Trajectory),
+// which includes the locations at which the projectile bounced.
 type GrenadeProjectileDestroy struct {
 	Projectile *common.GrenadeProjectile
 }
@@ -430,13 +466,14 @@ type BombEventIf interface {
 	implementsBombEventIf()
 }
 
-type Bombsite rune
+// Bombsite identifies a bomb site.
+type Bombsite = common.Bombsite
 
 // Bombsite identifiers
 const (
-	BomsiteUnknown Bombsite = 0
-	BombsiteA      Bombsite = 'A'
-	BombsiteB      Bombsite = 'B'
+	BomsiteUnknown = common.BomsiteUnknown
+	BombsiteA      = common.BombsiteA
+	BombsiteB      = common.BombsiteB
 )
 
 // BombEvent contains the common attributes of bomb events. Dont register
@@ -504,11 +541,24 @@ type BombPickup struct {
 }
 
 // HostageRescued signals that a hostage has been rescued.
+// Player is the player that carried the hostage into the rescue zone.
 type HostageRescued struct {
 	Player  *common.Player
 	Hostage *common.Hostage
 }
 
+// HostagePickup signals that a player picked up a hostage (or that a hostage started following a player).
+type HostagePickup struct {
+	Player  *common.Player // May be nil with POV demos
+	Hostage *common.Hostage
+}
+
+// HostageDrop signals that a hostage was dropped without being rescued (e.g. because the carrier died).
+type HostageDrop struct {
+	Player  *common.Player // The player that carried the hostage, may be nil with POV demos
+	Hostage *common.Hostage
+}
+
 // HostageRescuedAll signals that all hostages have been rescued.
 type HostageRescuedAll struct{}
 
@@ -630,6 +680,68 @@ type SayText2 struct {
 	IsChatAll bool     // Seems to always be false, team chat might not be recorded
 }
 
+// RadioMessage signals that a player used a radio command (CCSUsrMsg_RadioText).
+type RadioMessage struct {
+	Sender      *common.Player // May be nil if the sender can't be found
+	SenderSlot  int            // Client slot of the sender (entity-ID - 1), -1 if unknown
+	Destination TextMessageDestination
+	MsgName     string   // The message type, e.g. #Game_radio_location
+	Params      []string // The message's parameters, usually the sender's name, location and the radio command's token
+}
+
+// Token returns the localisation token of the radio command, e.g. #Cstrike_TitlesTXT_Enemy_Spotted.
+// This is the last parameter starting with '#' or MsgName if there is none.
+func (rm RadioMessage) Token() string {
+	for i := len(rm.Params) - 1; i >= 0; i-- {
+		if strings.HasPrefix(rm.Params[i], "#") {
+			return rm.Params[i]
+		}
+	}
+
+	return rm.MsgName
+}
+
+// HintText signals that a hint message has been sent to the HUD (CCSUsrMsg_HintText).
+type HintText struct {
+	Message string
+}
+
+// KeyHintText signals that key hint messages have been sent to the HUD (CCSUsrMsg_KeyHintText).
+type KeyHintText struct {
+	Messages []string
+}
+
+// HudText signals that a text has been sent to the HUD (CCSUsrMsg_HudText / CUserMessageHudText).
+type HudText struct {
+	Text string
+}
+
+// TextMessageDestination is the type for the various TextMessageDestinationXYZ constants.
+//
+// See TextMessage.
+type TextMessageDestination int
+
+// TextMessageDestination constants tell where a TextMessage is displayed.
+const (
+	TextMessageDestinationNotify  TextMessageDestination = 1
+	TextMessageDestinationConsole TextMessageDestination = 2
+	TextMessageDestinationTalk    TextMessageDestination = 3
+	TextMessageDestinationCenter  TextMessageDestination = 4
+)
+
+// TextMessage signals a server / admin / plugin text message (CUserMessageTextMsg).
+// The first parameter is usually the (possibly localised) message, the others are format arguments.
+type TextMessage struct {
+	Destination TextMessageDestination
+	Params      []string
+}
+
+// SendAudio signals that an announcer sound has been played (CCSUsrMsg_SendAudio / CUserMessageSendAudio).
+type SendAudio struct {
+	Sound string // E.g. Event.CTWin
+	Stop  bool   // True if the sound is being stopped instead of started
+}
+
 // TickRateInfoAvailable signals that the tick-rate information has been received via CSVCMsg_ServerInfo.
 // This can be useful for corrupt demo headers where the tick-rate is missing in the beginning of the demo.
 type TickRateInfoAvailable struct {
@@ -663,6 +775,79 @@ func (ru RankUpdate) SteamID64() uint64 {
 	return common.ConvertSteamID32To64(uint32(ru.SteamID32))
 }
 
+// VoiceData signals that a chunk of voice data has been received via CSVCMsg_VoiceData.
+// It contains the raw (encoded) audio payload, see the voice package for reassembling and decoding it.
+type VoiceData struct {
+	Player        *common.Player // May be nil if the speaker can't be found (e.g. already disconnected)
+	Client        int            // Client slot of the speaker (entity-ID - 1)
+	SteamID64     uint64
+	Format        msgs2.VoiceDataFormatT
+	SampleRate    int
+	SequenceBytes int
+	SectionNumber int
+	NumPackets    int
+	PacketOffsets []uint32 // End offsets of the individual packets in Data, only set for VOICEDATA_FORMAT_OPUS
+	Data          []byte   // The raw encoded payload
+	Proximity     bool
+	AudibleMask   int
+	Tick          int // In-game tick at which the voice data was received
+}
+
+// RadarSpottedEntitiesUpdate signals that entities shown on the radar have been updated (CCSUsrMsg_ProcessSpottedEntityUpdate).
+// If NewUpdate is true, all previously spotted entities have been removed from the radar.
+// See also GameState.RadarSpotted().
+type RadarSpottedEntitiesUpdate struct {
+	NewUpdate bool
+	Entities  []*common.RadarSpottedEntity
+}
+
+// DamageTaken signals that the recording player of a POV demo received damage (CCSUsrMsg_Damage).
+// This is what's used by the game to show the damage direction indicator.
+// Only available in POV demos.
+type DamageTaken struct {
+	Player            *common.Player // The recording player, may be nil if it hasn't been detected yet
+	Victim            *common.Player // May be nil
+	VictimEntityIndex int
+	Amount            int
+	InflictorPosition r3.Vector // World position of the damage source
+}
+
+// HitReported signals that the recording player of a POV demo hit something (CCSUsrMsg_ReportHit).
+// Only available in POV demos.
+type HitReported struct {
+	Player    *common.Player // The recording player, may be nil if it hasn't been detected yet
+	Position  r3.Vector
+	Timestamp float32
+}
+
+// LastKillerDamage signals that the recording player of a POV demo received the damage summary
+// of the duel with their killer (CCSUsrMsg_SendLastKillerDamageToClient).
+// Only available in POV demos.
+type LastKillerDamage struct {
+	Player            *common.Player // The recording player, may be nil if it hasn't been detected yet
+	NumHitsGiven      int
+	DamageGiven       int
+	NumHitsTaken      int
+	DamageTaken       int
+	ActualDamageGiven int // DamageGiven capped by the remaining health of the killer
+	ActualDamageTaken int // DamageTaken capped by the remaining health of the recording player
+}
+
+// DamagePrediction signals that the client of the recording player predicted damage for a shot (CCSUsrMsg_DamagePrediction).
+// Only available in POV demos.
+type DamagePrediction struct {
+	Player               *common.Player // The recording player, may be nil if it hasn't been detected yet
+	Victim               *common.Player // May be nil
+	VictimSlot           int
+	VictimStartingHealth int
+	VictimDamage         int
+	CommandNumber        int
+	PelletIndex          int
+	ShootPosition        r3.Vector
+	ShootAngle           r3.Vector // Pitch, yaw & roll in degrees
+	AimPunch             r3.Vector // Pitch, yaw & roll in degrees
+}
+
 // OtherDeath signals that there has occurred a death of something that is not a player.
 // For example chickens.
 type OtherDeath struct {
@@ -762,6 +947,7 @@ type GenericGameEvent struct {
 
 // InfernoStart signals that the fire of a incendiary or Molotov is starting.
 // This is different from the FireGrenadeStart because it's sent out when the inferno entity is created instead of on the game-event.
+// Inferno.Projectile contains the grenade projectile that started the fire, if it could be found.
 type InfernoStart struct {
 	Inferno *common.Inferno
 }
@@ -770,10 +956,21 @@ type InfernoStart struct {
 // This is different from the FireGrenadeExpire event because it's sent out when the inferno entity is destroyed instead of on the game-event.
 //
 // Mainly useful for getting the final area of an inferno.
+// Inferno.ExtinguishedBySmoke may not be up to date yet if a smoke put out the fire in the same frame,
+// see InfernoFireExtinguishedBySmoke.
 type InfernoExpired struct {
 	Inferno *common.Inferno
 }
 
+// InfernoFireExtinguishedBySmoke signals that a fire of an incendiary or Molotov was put out by a smoke.
+// It's dispatched at the end of the frame in which the fire stopped burning, as the smoke may only become active later
+// in the same frame. For fires that were still burning when the inferno expired it's dispatched after InfernoExpired.
+type InfernoFireExtinguishedBySmoke struct {
+	Inferno *common.Inferno
+	Fire    *common.Fire
+	Smoke   *common.Smoke
+}
+
 type InfernoFireStart struct {
 	Inferno *common.Inferno
 	Index   int
@@ -856,6 +1053,27 @@ type ItemRefund struct {
 	Weapon *common.Equipment
 }
 
+// ItemPurchase signals that a player bought an item.
+// Only items that are backed by an entity (weapons, grenades & the Zeus) are detected, armor and defuse kits aren't
+// (see ArmorUpdate, HelmetUpdate and DefuseKitUpdate).
+// It's dispatched at the end of the frame in which the item was created.
+// Available with CS2 demos only.
+type ItemPurchase struct {
+	Buyer *common.Player
+	Item  *common.Equipment
+	Cost  int // See common.EquipmentCost, the buyer's money decrease if the price is unknown
+	Tick  int
+}
+
+// ItemGift signals that a player picked up an item that a teammate bought during the same round.
+// Only the first pickup by a player other than the buyer is taken into account.
+// Available with CS2 demos only.
+type ItemGift struct {
+	Buyer     *common.Player
+	Recipient *common.Player
+	Item      *common.Equipment
+}
+
 // TeamClanNameUpdated signals that a team's clan name has been changed.
 type TeamClanNameUpdated struct {
 	OldName   string
@@ -292,7 +292,7 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"vote_cast":                      nil,                              // Dunno, only present in POV demos
 		"weapon_fire":                    delayIfNoPlayers(geh.weaponFire), // Weapon was fired
 		"weapon_fire_on_empty":           nil,                              // Sounds boring
-		"weapon_reload":                  nil,                              // Weapon reloaded
+		"weapon_reload":                  geh.weaponReload,                 // Weapon reload started
 		"weapon_zoom":                    geh.weaponZoom,                   // Zooming in
 		"weapon_zoom_rifle":              nil,                              // Dunno, only in locally recorded (POV) demo
 		"entity_killed":                  nil,
@@ -531,19 +531,23 @@ func (geh gameEventHandler) weaponFire(data map[string]*msg.CSVCMsg_GameEventKey
 	}
 }
 
-// func (geh gameEventHandler) weaponReload(data map[string]*msg.CSVCMsg_GameEventKeyT) {
-// 	pl := geh.playerByUserID32(data["userid"].GetValShort())
-// 	if pl == nil {
-// 		// see #162, "unknown" players since November 2019 update
-// 		return
-// 	}
+// weaponReload is only used as fallback if the weapon entity doesn't have the m_bInReload prop
+// or if disableMimicSource1GameEvents is set.
+// Otherwise the reload has usually already been detected from prop updates, see bindWeaponReload.
+func (geh gameEventHandler) weaponReload(data map[string]*msg.CSVCMsg_GameEventKeyT) {
+	pl := geh.playerByUserID32(data["userid"].GetValShort())
+	if pl == nil {
+		// see #162, "unknown" players since November 2019 update
+		return
+	}
 
-// 	pl.IsReloading = true
+	wep := pl.ActiveWeapon()
+	if wep == nil {
+		return
+	}
 
-// 	geh.dispatch(events.WeaponReload{
-// 		Player: pl,
-// 	})
-// }
+	geh.parser.weaponReloadBegin(pl, wep)
+}
 
 func (geh gameEventHandler) playerDeath(data map[string]*msg.CSVCMsg_GameEventKeyT) {
 	killer := geh.playerByUserID32(data["attacker"].GetValShort())
@@ -625,8 +629,12 @@ func (geh gameEventHandler) flashBangDetonate(data map[string]*msg.CSVCMsg_GameE
 }
 
 func (geh gameEventHandler) heGrenadeDetonate(data map[string]*msg.CSVCMsg_GameEventKeyT) {
+	nadeEvent := geh.nadeEvent(data, common.EqHE)
+
+	geh.parser.disperseSmokes(nadeEvent.Position)
+
 	geh.dispatch(events.HeExplode{
-		GrenadeEvent: geh.nadeEvent(data, common.EqHE),
+		GrenadeEvent: nadeEvent,
 	})
 }
 
@@ -655,6 +663,10 @@ func (geh gameEventHandler) smokeGrenadeDetonate(data map[string]*msg.CSVCMsg_Ga
 
 func (geh gameEventHandler) smokeGrenadeExpired(data map[string]*msg.CSVCMsg_GameEventKeyT) {
 	event := geh.nadeEvent(data, common.EqSmoke)
+	if smk, ok := geh.gameState().smokes[event.GrenadeEntityID]; ok {
+		geh.parser.smokeExpired(smk)
+	}
+
 	geh.dispatch(events.SmokeExpired{
 		GrenadeEvent: event,
 	})
@@ -693,6 +705,11 @@ func (geh gameEventHandler) hostageKilled(data map[string]*msg.CSVCMsg_GameEvent
 }
 
 func (geh gameEventHandler) hostageRescued(data map[string]*msg.CSVCMsg_GameEventKeyT) {
+	// dispatched based on m_nHostageState updates, see bindHostages()
+	if geh.parser.isSource2() && !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
 	event := events.HostageRescued{
 		Player:  geh.playerByUserID32(data["userid"].GetValShort()),
 		Hostage: geh.gameState().hostages[int(data["hostage"].GetValShort())],
@@ -1243,8 +1260,14 @@ func (p *parser) processFrameGameEvents() {
 		p.processRoundProgressEvents()
 	}
 
-	for _, eventHandler := range p.delayedEventHandlers {
-		eventHandler()
+	// Handlers may queue further delayed handlers, those are executed in the same frame, after all handlers queued before them.
+	// Ranging over the slice would skip and then drop them. E.g.:
+	// - a Kill dispatched by a delayed player_death queues its TradeKill (see bindTrades()),
+	//   which is therefore always dispatched after the Kill and all of its handlers, in the same frame
+	// - the clutch check of bindClutches() re-queues itself behind handlers that were queued after it,
+	//   so it runs exactly once per frame, after everything that may change the alive players
+	for i := 0; i < len(p.delayedEventHandlers); i++ {
+		p.delayedEventHandlers[i]()
 	}
 
 	p.delayedEventHandlers = p.delayedEventHandlers[:0]
@@ -2,6 +2,7 @@ package demoinfocs
 
 import (
 	"errors"
+	"math"
 	"strconv"
 	"time"
 
@@ -70,12 +71,40 @@ type gameState struct {
 	defuseKits       map[int]*common.Equipment
 	lastFreezeEnd    int
 	roundTime        int
+	radarSpotted     map[int]*common.RadarSpottedEntity // Maps entity-IDs to entities currently shown on the radar
+	reloadingWeapons map[int]weaponReload               // Maps weapon entity-IDs to reloads in progress
+	rounds           []*common.Round                    // All rounds of the match so far, excluding warmup
+	matchEndTick     int                                // Tick at which the game phase changed to GamePhaseGameEnded, -1 if it hasn't (yet)
+	uniqueIDSequence int                                // Last sequence number handed out for unique IDs of objects without an entity
+
+	destroyedFireProjectiles []destroyedProjectile            // Recently destroyed molotov & incendiary projectiles, used to link infernos to them
+	hostageIDs               map[[3]int]int                   // Maps rounded spawn positions to hostage IDs, see common.Hostage.ID
+	hostageRescueZones       map[int]*boundingBoxInformation  // Maps entity-IDs to hostage rescue zones (world coordinates, like bombsite triggers)
+	moneyDecreases           map[*common.Player]moneyDecrease // Last decrease of each player's money, used to detect purchases
+}
+
+// moneyDecrease is a decrease of a player's money (m_iAccount), usually caused by a purchase.
+type moneyDecrease struct {
+	tick   int
+	amount int // Total decrease during the tick
 }
 
 func (gs *gameState) GetRoundTime() int {
 	return gs.roundTime
 }
 
+// destroyedProjectile is a grenade projectile that was destroyed at the given tick.
+type destroyedProjectile struct {
+	projectile *common.GrenadeProjectile
+	tick       int
+}
+
+// weaponReload contains the state of a reload in progress.
+type weaponReload struct {
+	player     *common.Player
+	ammoBefore int
+}
+
 type FlyingFlashbang struct {
 	projectile       *common.GrenadeProjectile
 	flashedEntityIDs []int
@@ -113,6 +142,7 @@ func (gs *gameState) setPlayerLifeState(pl *common.Player, alive *bool) {
 
 func UpdatePlayerPosition(pl *common.Player, pos r3.Vector, tick int) {
 	if pl.CurrPosition == nil || tick != pl.CurrPosition.Tick {
+		pl.PrevVelocity = pl.VelocityFromPositions()
 		pl.PrevPosition = pl.CurrPosition
 
 		pl.CurrPosition = &common.Position{
@@ -327,6 +357,116 @@ func (gs gameState) EntityByHandle(handle uint64) st.Entity {
 	return nil
 }
 
+// RadarSpotted returns a map from entity-IDs to all entities currently shown on the radar.
+// Entities are removed once they're destroyed.
+// This is based on CCSUsrMsg_ProcessSpottedEntityUpdate, see also events.RadarSpottedEntitiesUpdate.
+func (gs gameState) RadarSpotted() map[int]*common.RadarSpottedEntity {
+	return gs.radarSpotted
+}
+
+// CurrentRound returns the round that is currently being played or the last round if it has already ended.
+// Returns nil before the first round after the warmup.
+func (gs gameState) CurrentRound() *common.Round {
+	if len(gs.rounds) == 0 {
+		return nil
+	}
+
+	return gs.rounds[len(gs.rounds)-1]
+}
+
+// Rounds returns all rounds of the match so far, including the current one.
+// Rounds played during the warmup or reverted by mp_restartgame / round backups are not included.
+func (gs gameState) Rounds() []*common.Round {
+	return gs.rounds
+}
+
+// IsLineThroughSmoke returns true if the line segment between a and b passes through any active smoke.
+// See common.Smoke.IntersectsLine() for the approximation that's used.
+func (gs gameState) IsLineThroughSmoke(a, b r3.Vector) bool {
+	for _, smk := range gs.smokes {
+		if smk.IsActive && smk.IntersectsLine(a, b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInHostageRescueZone returns true if the given position is inside any of the hostage rescue zones of the map.
+func (gs gameState) IsInHostageRescueZone(pos r3.Vector) bool {
+	for _, zone := range gs.hostageRescueZones {
+		if zone.contains(pos) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HostagesRescued returns the number of hostages rescued by the given team (side) during the current round.
+func (gs gameState) HostagesRescued(team common.Team) int {
+	round := gs.CurrentRound()
+	if round == nil || round.Team(team) == nil {
+		return 0
+	}
+
+	return round.Team(team).HostagesRescued
+}
+
+// TotalHostagesRescued returns the number of hostages rescued by the team with the given clan name during the match.
+func (gs gameState) TotalHostagesRescued(clanName string) int {
+	total := 0
+
+	for _, round := range gs.rounds {
+		if team := round.Team(round.SideOf(clanName)); team != nil {
+			total += team.HostagesRescued
+		}
+	}
+
+	return total
+}
+
+// hostageID returns the ID of the hostage spawning at the given position.
+// Hostages that spawn at the same spawn point get the same ID, new spawn points get the next free ID.
+func (gs *gameState) hostageID(spawnPos r3.Vector) int {
+	key := [3]int{int(math.Round(spawnPos.X)), int(math.Round(spawnPos.Y)), int(math.Round(spawnPos.Z))}
+
+	id, ok := gs.hostageIDs[key]
+	if !ok {
+		id = len(gs.hostageIDs) + 1
+		gs.hostageIDs[key] = id
+	}
+
+	return id
+}
+
+// addHostageRescue counts a rescue for the team of the rescuer in the current round.
+// Only CTs can rescue hostages, so the rescue is attributed to them if the rescuer is unknown.
+func (gs *gameState) addHostageRescue(rescuer *common.Player) {
+	round := gs.CurrentRound()
+	if round == nil {
+		return
+	}
+
+	team := common.TeamCounterTerrorists
+	if rescuer != nil && round.Team(rescuer.Team) != nil {
+		team = rescuer.Team
+	}
+
+	round.Team(team).HostagesRescued++
+}
+
+// addMoneyDecrease records a decrease of the player's money, decreases during the same tick are summed up.
+func (gs *gameState) addMoneyDecrease(pl *common.Player, amount int) {
+	last := gs.moneyDecreases[pl]
+	if last.tick != gs.ingameTick {
+		last = moneyDecrease{tick: gs.ingameTick}
+	}
+
+	last.amount += amount
+	gs.moneyDecreases[pl] = last
+}
+
 func newGameState(demoInfo demoInfoProvider) *gameState {
 	gs := &gameState{
 		playerControllerEntities: make(map[int]st.Entity),
@@ -340,14 +480,20 @@ func newGameState(demoInfo demoInfoProvider) *gameState {
 		weapons:                  make(map[int]*common.Equipment),
 		wepsToRemove:             make(map[int]*common.Equipment),
 		hostages:                 make(map[int]*common.Hostage),
+		hostageIDs:               make(map[[3]int]int),
+		hostageRescueZones:       make(map[int]*boundingBoxInformation),
+		moneyDecreases:           make(map[*common.Player]moneyDecrease),
 		entities:                 make(map[int]st.Entity),
 		bomb:                     common.NewBomb(demoInfo),
 		thrownGrenades:           make(map[*common.Player][]*common.Equipment),
 		flyingFlashbangs:         make([]*FlyingFlashbang, 0),
 		defuseKits:               make(map[int]*common.Equipment),
+		radarSpotted:             make(map[int]*common.RadarSpottedEntity),
+		reloadingWeapons:         make(map[int]weaponReload),
 		rules:                    gameRules{conVars: make(map[string]string)},
 		demoInfo:                 demoInfo,
 		lastFreezeEnd:            -1,
+		matchEndTick:             -1,
 	}
 
 	gs.tState = common.NewTeamState(common.TeamTerrorists, gs.Participants().TeamMembers, gs.demoInfo)
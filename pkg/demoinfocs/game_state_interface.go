@@ -3,6 +3,7 @@
 package demoinfocs
 
 import (
+	"github.com/golang/geo/r3"
 	common "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
 	st "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
 )
@@ -71,4 +72,23 @@ type GameState interface {
 	// Returns nil if the handle is invalid.
 	EntityByHandle(handle uint64) st.Entity
 	GetRoundTime() int
+	// RadarSpotted returns a map from entity-IDs to all entities currently shown on the radar.
+	// Entities are removed once they're destroyed.
+	// This is based on CCSUsrMsg_ProcessSpottedEntityUpdate, see also events.RadarSpottedEntitiesUpdate.
+	RadarSpotted() map[int]*common.RadarSpottedEntity
+	// CurrentRound returns the round that is currently being played or the last round if it has already ended.
+	// Returns nil before the first round after the warmup.
+	CurrentRound() *common.Round
+	// Rounds returns all rounds of the match so far, including the current one.
+	// Rounds played during the warmup or reverted by mp_restartgame / round backups are not included.
+	Rounds() []*common.Round
+	// IsLineThroughSmoke returns true if the line segment between a and b passes through any active smoke.
+	// See common.Smoke.IntersectsLine() for the approximation that's used.
+	IsLineThroughSmoke(a, b r3.Vector) bool
+	// IsInHostageRescueZone returns true if the given position is inside any of the hostage rescue zones of the map.
+	IsInHostageRescueZone(pos r3.Vector) bool
+	// HostagesRescued returns the number of hostages rescued by the given team (side) during the current round.
+	HostagesRescued(team common.Team) int
+	// TotalHostagesRescued returns the number of hostages rescued by the team with the given clan name during the match.
+	TotalHostagesRescued(clanName string) int
 }
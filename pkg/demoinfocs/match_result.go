@@ -0,0 +1,175 @@
+package demoinfocs
+
+import (
+	common "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// MatchResult contains the result and metadata of a match, see Parser.MatchResult().
+type MatchResult struct {
+	MapName    string
+	ServerName string
+	TickRate   float64
+
+	NumRounds  int // Number of rounds played, excluding warmup
+	IsFinished bool
+
+	// Start and end tick of live play (excluding warmup), -1 if no round has been played.
+	// If the match didn't finish, LiveEndTick is the last tick of the demo.
+	LiveStartTick int
+	LiveEndTick   int
+
+	// Teams by the side they played on in the last round.
+	CT MatchTeamResult
+	T  MatchTeamResult
+
+	ScoreByClanName map[string]int // Final score by TeamState.ClanName(), only contains teams that have a clan name
+	Halves          []MatchHalf    // Results of the individual halves including overtime halves
+
+	Winner         common.Team // Side the winner played on in the last round, TeamUnassigned if there is no winner (yet), i.e. if the match isn't finished and nobody surrendered
+	WinnerClanName string
+	Surrendered    common.Team // Side that surrendered / forfeited the match, TeamUnassigned if none
+}
+
+// MatchTeamResult contains the result of a team.
+type MatchTeamResult struct {
+	ClanName     string
+	Score        int
+	StartingSide common.Team // Side the team played on in the first round
+}
+
+// MatchHalf contains the result of a half (or overtime half).
+type MatchHalf struct {
+	Number         int // 1-based
+	OvertimeNumber int // 0 for regulation
+	FirstRound     int // Number of the first round of the half
+	LastRound      int // Number of the last round of the half
+
+	CT MatchHalfTeam
+	T  MatchHalfTeam
+}
+
+// MatchHalfTeam contains the result of a team during a half.
+type MatchHalfTeam struct {
+	ClanName  string
+	RoundsWon int
+	Score     int // Score at the end of the half, e.g. the halftime score
+}
+
+// newMatchResult creates a MatchResult for the current state of the game.
+func (p *parser) newMatchResult() *MatchResult {
+	gs := p.gameState
+
+	res := &MatchResult{
+		TickRate:        p.TickRate(),
+		NumRounds:       len(gs.rounds),
+		IsFinished:      gs.matchEndTick != -1,
+		LiveStartTick:   -1,
+		LiveEndTick:     -1,
+		ScoreByClanName: make(map[string]int),
+		Surrendered:     common.TeamUnassigned,
+	}
+
+	if p.header != nil {
+		res.MapName = p.header.MapName
+		res.ServerName = p.header.ServerName
+	}
+
+	sideSwitches := 0
+
+	for _, round := range gs.rounds {
+		if round.SidesSwitched {
+			sideSwitches++
+		}
+
+		res.addRoundToHalves(round)
+	}
+
+	for _, team := range []common.Team{common.TeamCounterTerrorists, common.TeamTerrorists} {
+		state := gs.Team(team)
+		result := res.team(team)
+
+		result.ClanName = state.ClanName()
+		result.Score = state.Score()
+		result.StartingSide = team
+
+		if sideSwitches%2 == 1 {
+			result.StartingSide = state.Opponent.Team()
+		}
+
+		if result.ClanName != "" {
+			res.ScoreByClanName[result.ClanName] = result.Score
+		}
+	}
+
+	if len(gs.rounds) > 0 {
+		first, last := gs.rounds[0], gs.rounds[len(gs.rounds)-1]
+
+		res.LiveStartTick = first.StartTick
+		res.LiveEndTick = gs.ingameTick
+
+		if gs.matchEndTick != -1 {
+			res.LiveEndTick = gs.matchEndTick
+		}
+
+		switch last.EndReason {
+		case events.RoundEndReasonTerroristsSurrender:
+			res.Surrendered = common.TeamTerrorists
+		case events.RoundEndReasonCTSurrender:
+			res.Surrendered = common.TeamCounterTerrorists
+		}
+	}
+
+	// the score only decides the winner once the match is over, not for demos that end mid-match
+	switch {
+	case res.Surrendered != common.TeamUnassigned:
+		res.Winner = gs.Team(res.Surrendered).Opponent.Team()
+	case res.IsFinished && res.CT.Score > res.T.Score:
+		res.Winner = common.TeamCounterTerrorists
+	case res.IsFinished && res.T.Score > res.CT.Score:
+		res.Winner = common.TeamTerrorists
+	default:
+		res.Winner = common.TeamUnassigned
+	}
+
+	if winner := res.team(res.Winner); winner != nil {
+		res.WinnerClanName = winner.ClanName
+	}
+
+	return res
+}
+
+func (res *MatchResult) team(team common.Team) *MatchTeamResult {
+	switch team {
+	case common.TeamCounterTerrorists:
+		return &res.CT
+	case common.TeamTerrorists:
+		return &res.T
+	default:
+		return nil
+	}
+}
+
+func (res *MatchResult) addRoundToHalves(round *common.Round) {
+	if len(res.Halves) == 0 || res.Halves[len(res.Halves)-1].Number != round.Half {
+		res.Halves = append(res.Halves, MatchHalf{
+			Number:         round.Half,
+			OvertimeNumber: round.OvertimeNumber,
+			FirstRound:     round.Number,
+		})
+	}
+
+	half := &res.Halves[len(res.Halves)-1]
+	half.LastRound = round.Number
+	half.CT.ClanName = round.CT.ClanName
+	half.CT.Score = round.CT.ScoreAfter
+	half.T.ClanName = round.T.ClanName
+	half.T.Score = round.T.ScoreAfter
+
+	switch round.Winner {
+	case common.TeamCounterTerrorists:
+		half.CT.RoundsWon++
+	case common.TeamTerrorists:
+		half.T.RoundsWon++
+	}
+}
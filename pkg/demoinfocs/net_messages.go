@@ -3,8 +3,10 @@ package demoinfocs
 import (
 	"fmt"
 
+	"github.com/golang/geo/r3"
 	"github.com/markus-wa/go-unassert"
 
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
 	events "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
 	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/msgs2"
 	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/sendtables"
@@ -18,6 +20,7 @@ func (p *parser) onEntity(e sendtables.Entity, op sendtables.EntityOp) error {
 			player.Entity = nil
 		}
 		delete(p.gameState.entities, e.ID())
+		delete(p.gameState.radarSpotted, e.ID())
 	}
 
 	return nil
@@ -114,6 +117,41 @@ func (p *parser) handleMessageSayText2(msg *msgs2.CUserMessageSayText2) {
 	}
 }
 
+func (p *parser) handleVoiceData(msg *msgs2.CSVCMsg_VoiceData) {
+	audio := msg.GetAudio()
+	if audio == nil {
+		return
+	}
+
+	client := int(msg.GetClient())
+	xuid := msg.GetXuid()
+
+	player := p.gameState.playersByEntityID[client+1]
+	if player == nil && xuid != 0 {
+		player = p.gameState.playersBySteamID32[common.ConvertSteamID64To32(xuid)]
+	}
+
+	if xuid == 0 && player != nil {
+		xuid = player.SteamID64
+	}
+
+	p.eventDispatcher.Dispatch(events.VoiceData{
+		Player:        player,
+		Client:        client,
+		SteamID64:     xuid,
+		Format:        audio.GetFormat(),
+		SampleRate:    int(audio.GetSampleRate()),
+		SequenceBytes: int(audio.GetSequenceBytes()),
+		SectionNumber: int(audio.GetSectionNumber()),
+		NumPackets:    int(audio.GetNumPackets()),
+		PacketOffsets: audio.GetPacketOffsets(),
+		Data:          audio.GetVoiceData(),
+		Proximity:     msg.GetProximity(),
+		AudibleMask:   int(msg.GetAudibleMask()),
+		Tick:          p.gameState.ingameTick,
+	})
+}
+
 func (p *parser) handleServerRankUpdate(msg *msgs2.CCSUsrMsg_ServerRankUpdate) {
 	for _, v := range msg.RankUpdate {
 		steamID32 := uint32(v.GetAccountId())
@@ -135,3 +173,206 @@ func (p *parser) handleServerRankUpdate(msg *msgs2.CCSUsrMsg_ServerRankUpdate) {
 		})
 	}
 }
+
+// recordingPlayer returns the player that recorded a POV demo or nil if it's not known (yet).
+func (p *parser) recordingPlayer() *common.Player {
+	if p.recordingPlayerSlot == -1 {
+		return nil
+	}
+
+	return p.gameState.playersByEntityID[p.recordingPlayerSlot+1]
+}
+
+// playerByEntityIndex returns the player with the given controller or pawn entity-index.
+func (p *parser) playerByEntityIndex(index int) *common.Player {
+	if pl := p.gameState.playersByEntityID[index]; pl != nil {
+		return pl
+	}
+
+	for _, pl := range p.gameState.playersByEntityID {
+		pawn := pl.PlayerPawnEntity()
+		if pawn != nil && pawn.ID() == index {
+			return pl
+		}
+	}
+
+	return nil
+}
+
+func msgVectorToR3(v *msgs2.CMsgVector) r3.Vector {
+	return r3.Vector{
+		X: float64(v.GetX()),
+		Y: float64(v.GetY()),
+		Z: float64(v.GetZ()),
+	}
+}
+
+func msgQAngleToR3(a *msgs2.CMsgQAngle) r3.Vector {
+	return r3.Vector{
+		X: float64(a.GetX()),
+		Y: float64(a.GetY()),
+		Z: float64(a.GetZ()),
+	}
+}
+
+func (p *parser) handleDamage(msg *msgs2.CCSUsrMsg_Damage) {
+	victimIndex := int(msg.GetVictimEntindex())
+
+	p.eventDispatcher.Dispatch(events.DamageTaken{
+		Player:            p.recordingPlayer(),
+		Victim:            p.playerByEntityIndex(victimIndex),
+		VictimEntityIndex: victimIndex,
+		Amount:            int(msg.GetAmount()),
+		InflictorPosition: msgVectorToR3(msg.GetInflictorWorldPos()),
+	})
+}
+
+func (p *parser) handleReportHit(msg *msgs2.CCSUsrMsg_ReportHit) {
+	p.eventDispatcher.Dispatch(events.HitReported{
+		Player: p.recordingPlayer(),
+		Position: r3.Vector{
+			X: float64(msg.GetPosX()),
+			Y: float64(msg.GetPosY()),
+			Z: float64(msg.GetPosZ()),
+		},
+		Timestamp: msg.GetTimestamp(),
+	})
+}
+
+func (p *parser) handleSendLastKillerDamageToClient(msg *msgs2.CCSUsrMsg_SendLastKillerDamageToClient) {
+	p.eventDispatcher.Dispatch(events.LastKillerDamage{
+		Player:            p.recordingPlayer(),
+		NumHitsGiven:      int(msg.GetNumHitsGiven()),
+		DamageGiven:       int(msg.GetDamageGiven()),
+		NumHitsTaken:      int(msg.GetNumHitsTaken()),
+		DamageTaken:       int(msg.GetDamageTaken()),
+		ActualDamageGiven: int(msg.GetActualDamageGiven()),
+		ActualDamageTaken: int(msg.GetActualDamageTaken()),
+	})
+}
+
+func (p *parser) handleDamagePrediction(msg *msgs2.CCSUsrMsg_DamagePrediction) {
+	victimSlot := int(msg.GetVictimSlot())
+
+	p.eventDispatcher.Dispatch(events.DamagePrediction{
+		Player:               p.recordingPlayer(),
+		Victim:               p.gameState.playersByEntityID[victimSlot+1],
+		VictimSlot:           victimSlot,
+		VictimStartingHealth: int(msg.GetVictimStartingHealth()),
+		VictimDamage:         int(msg.GetVictimDamage()),
+		CommandNumber:        int(msg.GetCommandNum()),
+		PelletIndex:          int(msg.GetPelletIdx()),
+		ShootPosition:        msgVectorToR3(msg.GetShootPos()),
+		ShootAngle:           msgQAngleToR3(msg.GetShootDir()),
+		AimPunch:             msgQAngleToR3(msg.GetAimPunch()),
+	})
+}
+
+// radarOriginScale is the factor by which origins in CCSUsrMsg_ProcessSpottedEntityUpdate are scaled down.
+const radarOriginScale = 4
+
+func (p *parser) serverClassName(classID int) string {
+	if p.stParser == nil {
+		return ""
+	}
+
+	if sc := p.stParser.ServerClassByID(classID); sc != nil {
+		return sc.Name()
+	}
+
+	return ""
+}
+
+func (p *parser) handleProcessSpottedEntityUpdate(msg *msgs2.CCSUsrMsg_ProcessSpottedEntityUpdate) {
+	if msg.GetNewUpdate() {
+		clear(p.gameState.radarSpotted)
+	}
+
+	updated := make([]*common.RadarSpottedEntity, 0, len(msg.GetEntityUpdates()))
+
+	for _, u := range msg.GetEntityUpdates() {
+		entityID := int(u.GetEntityIdx())
+		classID := int(u.GetClassId())
+
+		spotted := &common.RadarSpottedEntity{
+			EntityID:  entityID,
+			ClassID:   classID,
+			ClassName: p.serverClassName(classID),
+			Player:    p.playerByEntityIndex(entityID),
+			Position: r3.Vector{
+				X: float64(u.GetOriginX() * radarOriginScale),
+				Y: float64(u.GetOriginY() * radarOriginScale),
+				Z: float64(u.GetOriginZ() * radarOriginScale),
+			},
+			Yaw:              float64(u.GetAngleY()),
+			IsDefuser:        u.GetDefuser(),
+			PlayerHasDefuser: u.GetPlayerHasDefuser(),
+			PlayerHasC4:      u.GetPlayerHasC4(),
+			Tick:             p.gameState.ingameTick,
+		}
+
+		p.gameState.radarSpotted[entityID] = spotted
+		updated = append(updated, spotted)
+	}
+
+	p.eventDispatcher.Dispatch(events.RadarSpottedEntitiesUpdate{
+		NewUpdate: msg.GetNewUpdate(),
+		Entities:  updated,
+	})
+}
+
+func (p *parser) handleRadioText(msg *msgs2.CCSUsrMsg_RadioText) {
+	slot := int(msg.GetClient())
+
+	p.eventDispatcher.Dispatch(events.RadioMessage{
+		Sender:      p.gameState.playersByEntityID[slot+1],
+		SenderSlot:  slot,
+		Destination: events.TextMessageDestination(msg.GetMsgDst()),
+		MsgName:     msg.GetMsgName(),
+		Params:      msg.GetParams(),
+	})
+}
+
+func (p *parser) handleHintText(msg *msgs2.CCSUsrMsg_HintText) {
+	p.eventDispatcher.Dispatch(events.HintText{
+		Message: msg.GetMessage(),
+	})
+}
+
+func (p *parser) handleKeyHintText(msg *msgs2.CCSUsrMsg_KeyHintText) {
+	p.eventDispatcher.Dispatch(events.KeyHintText{
+		Messages: msg.GetMessages(),
+	})
+}
+
+func (p *parser) handleHudText(msg *msgs2.CCSUsrMsg_HudText) {
+	p.eventDispatcher.Dispatch(events.HudText{
+		Text: msg.GetText(),
+	})
+}
+
+func (p *parser) handleUserMessageHudText(msg *msgs2.CUserMessageHudText) {
+	p.eventDispatcher.Dispatch(events.HudText{
+		Text: msg.GetMessage(),
+	})
+}
+
+func (p *parser) handleTextMsg(msg *msgs2.CUserMessageTextMsg) {
+	p.eventDispatcher.Dispatch(events.TextMessage{
+		Destination: events.TextMessageDestination(msg.GetDest()),
+		Params:      msg.GetParam(),
+	})
+}
+
+func (p *parser) handleSendAudio(msg *msgs2.CCSUsrMsg_SendAudio) {
+	p.eventDispatcher.Dispatch(events.SendAudio{
+		Sound: msg.GetRadioSound(),
+	})
+}
+
+func (p *parser) handleUserMessageSendAudio(msg *msgs2.CUserMessageSendAudio) {
+	p.eventDispatcher.Dispatch(events.SendAudio{
+		Sound: msg.GetSoundname(),
+		Stop:  msg.GetStop(),
+	})
+}
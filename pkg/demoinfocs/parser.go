@@ -78,6 +78,8 @@ type parser struct {
 	 */
 	recordingPlayerSlot           int
 	disableMimicSource1GameEvents bool
+	playerHistoryTicks            int
+	tradeWindow                   time.Duration
 
 	// Additional fields, mainly caching & tracking things
 
@@ -105,6 +107,7 @@ type bombsite struct {
 	center r3.Vector
 }
 
+// boundingBoxInformation is an axis-aligned box of a trigger (bombsites & hostage rescue zones) in world coordinates.
 type boundingBoxInformation struct {
 	min r3.Vector
 	max r3.Vector
@@ -206,6 +209,12 @@ func (p *parser) Progress() float32 {
 	return float32(p.currentFrame) / float32(p.header.PlaybackFrames)
 }
 
+// MatchResult returns the result and metadata of the match such as final & halftime scores, the winner and the map.
+// Should be called after ParseToEnd(), before that it contains the result of the match so far.
+func (p *parser) MatchResult() *MatchResult {
+	return p.newMatchResult()
+}
+
 /*
 RegisterEventHandler registers a handler for game events.
 
@@ -344,6 +353,16 @@ type ParserConfig struct {
 	// IgnorePacketEntitiesPanic tells the parser to ignore PacketEntities parsing panics.
 	// This is required as a workaround for some POV demos that seem to contain rare PacketEntities parsing issues.
 	IgnorePacketEntitiesPanic bool
+
+	// PlayerHistoryTicks tells the parser to keep the state of each player for the last PlayerHistoryTicks ticks.
+	// The history can be queried via Player.StateAt() and Player.History().
+	// The state is recorded once per frame, so fewer states may be kept if the demo was recorded at a lower tick-rate.
+	// Zero (default) disables the history.
+	PlayerHistoryTicks int
+
+	// TradeWindow is the maximum time between two kills for the second one to count as a trade, see events.TradeKill.
+	// Zero (default) uses DefaultTradeWindow.
+	TradeWindow time.Duration
 }
 
 // DefaultParserConfig is the default Parser configuration used by NewParser().
@@ -374,6 +393,8 @@ func NewParserWithConfig(demostream io.Reader, config ParserConfig) Parser {
 	p.disableMimicSource1GameEvents = config.DisableMimicSource1Events
 	p.source2FallbackGameEventListBin = config.Source2FallbackGameEventListBin
 	p.ignorePacketEntitiesPanic = config.IgnorePacketEntitiesPanic
+	p.playerHistoryTicks = config.PlayerHistoryTicks
+	p.tradeWindow = config.TradeWindow
 
 	dispatcherCfg := dp.Config{
 		PanicHandler: func(v any) {
@@ -383,6 +404,11 @@ func NewParserWithConfig(demostream io.Reader, config ParserConfig) Parser {
 	p.msgDispatcher = dp.NewDispatcherWithConfig(dispatcherCfg)
 	p.eventDispatcher = dp.NewDispatcherWithConfig(dispatcherCfg)
 
+	// Attach internal event handlers, before any user handlers
+	p.bindRounds()
+	p.bindTrades()
+	p.bindClutches()
+
 	// Attach proto msg handlers
 	p.msgDispatcher.RegisterHandler(p.handleGameEventList)
 	p.msgDispatcher.RegisterHandler(p.handleGameEvent)
@@ -401,6 +427,20 @@ func NewParserWithConfig(demostream io.Reader, config ParserConfig) Parser {
 	p.msgDispatcher.RegisterHandler(p.handleUpdateStringTableS2)
 	p.msgDispatcher.RegisterHandler(p.handleSetConVarS2)
 	p.msgDispatcher.RegisterHandler(p.handleServerRankUpdate)
+	p.msgDispatcher.RegisterHandler(p.handleVoiceData)
+	p.msgDispatcher.RegisterHandler(p.handleDamage)
+	p.msgDispatcher.RegisterHandler(p.handleReportHit)
+	p.msgDispatcher.RegisterHandler(p.handleSendLastKillerDamageToClient)
+	p.msgDispatcher.RegisterHandler(p.handleDamagePrediction)
+	p.msgDispatcher.RegisterHandler(p.handleProcessSpottedEntityUpdate)
+	p.msgDispatcher.RegisterHandler(p.handleRadioText)
+	p.msgDispatcher.RegisterHandler(p.handleHintText)
+	p.msgDispatcher.RegisterHandler(p.handleKeyHintText)
+	p.msgDispatcher.RegisterHandler(p.handleHudText)
+	p.msgDispatcher.RegisterHandler(p.handleUserMessageHudText)
+	p.msgDispatcher.RegisterHandler(p.handleTextMsg)
+	p.msgDispatcher.RegisterHandler(p.handleSendAudio)
+	p.msgDispatcher.RegisterHandler(p.handleUserMessageSendAudio)
 	p.msgDispatcher.RegisterHandler(p.handleMessageSayText)
 	p.msgDispatcher.RegisterHandler(p.handleMessageSayText2)
 	p.msgDispatcher.RegisterHandler(p.handleSendTables)
@@ -479,3 +519,9 @@ func (p demoInfoProvider) Bomb() *common.Bomb {
 func (p demoInfoProvider) Weapons() map[int]*common.Equipment {
 	return p.parser.gameState.weapons
 }
+
+func (p demoInfoProvider) NextUniqueIDSequence() int {
+	p.parser.gameState.uniqueIDSequence++
+
+	return p.parser.gameState.uniqueIDSequence
+}
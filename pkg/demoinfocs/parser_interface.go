@@ -65,6 +65,9 @@ type Parser interface {
 	// Might not be 100% correct since it's just based on the reported tick count of the header.
 	// May always return 0 if the demo header is corrupt.
 	Progress() float32
+	// MatchResult returns the result and metadata of the match such as final & halftime scores, the winner and the map.
+	// Should be called after ParseToEnd(), before that it contains the result of the match so far.
+	MatchResult() *MatchResult
 	/*
 	   RegisterEventHandler registers a handler for game events.
 
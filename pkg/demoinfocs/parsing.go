@@ -339,10 +339,28 @@ func (p *parser) handleFrameParsed(*frameParsedTokenType) {
 		delete(p.gameState.wepsToRemove, entityID)
 	}
 
+	p.recordPlayerHistory()
+
 	p.currentFrame++
 	p.eventDispatcher.Dispatch(events.FrameDone{})
 }
 
+// recordPlayerHistory records the state of all connected players, see ParserConfig.PlayerHistoryTicks.
+func (p *parser) recordPlayerHistory() {
+	if p.playerHistoryTicks <= 0 {
+		return
+	}
+
+	for _, pl := range p.gameState.playersByEntityID {
+		if pl.Entity == nil {
+			continue
+		}
+
+		pl.EnableHistory(p.playerHistoryTicks)
+		pl.RecordState(p.gameState.ingameTick)
+	}
+}
+
 // CS2 demos playback info are available in the CDemoFileInfo message that should be parsed at the end of the demo.
 // Demos may not contain it, as a workaround we update values with the last parser information at the end of parsing.
 func (p *parser) ensurePlaybackValuesAreSet() {
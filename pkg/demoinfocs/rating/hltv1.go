@@ -0,0 +1,51 @@
+package rating
+
+// Averages of professional players used to normalize the HLTV 1.0 rating, as published by HLTV.org.
+const (
+	hltv1AverageKPR          = 0.679 // Kills per round
+	hltv1AverageSPR          = 0.317 // Survived rounds per round
+	hltv1AverageRMK          = 1.277 // Weighted rounds with multiple kills per round
+	hltv1SurvivalWeight      = 0.7
+	hltv1TotalWeight         = 2.7
+	hltv1MaxKillsPerRoundRMK = 5
+)
+
+// HLTV1 is the HLTV.org Rating 1.0 (2010).
+//
+//	Rating = (KillRating + 0.7 * SurvivalRating + RoundsWithMultipleKillsRating) / 2.7
+//
+//	KillRating                    = Kills / Rounds / 0.679
+//	SurvivalRating                = (Rounds - Deaths) / Rounds / 0.317
+//	RoundsWithMultipleKillsRating = (1K + 4 * 2K + 9 * 3K + 16 * 4K + 25 * 5K) / Rounds / 1.277
+//
+// Where nK is the number of rounds with n kills. An average player has a rating of 1.0.
+// Returns 0 if the player didn't play any rounds.
+//
+// See Weighted for a formula with configurable weights that also takes damage, KAST and impact into account.
+type HLTV1 struct{}
+
+// Name returns "HLTV 1.0".
+func (HLTV1) Name() string {
+	return "HLTV 1.0"
+}
+
+// Rate returns the HLTV 1.0 rating for the given input.
+func (HLTV1) Rate(in Input) float64 {
+	if in.Rounds == 0 {
+		return 0
+	}
+
+	rounds := float64(in.Rounds)
+
+	killRating := float64(in.Kills) / rounds / hltv1AverageKPR
+	survivalRating := float64(in.Rounds-in.Deaths) / rounds / hltv1AverageSPR
+
+	var multiKills int
+	for n := 1; n <= hltv1MaxKillsPerRoundRMK; n++ {
+		multiKills += n * n * in.RoundsWithKills[n]
+	}
+
+	multiKillRating := float64(multiKills) / rounds / hltv1AverageRMK
+
+	return (killRating + hltv1SurvivalWeight*survivalRating + multiKillRating) / hltv1TotalWeight
+}
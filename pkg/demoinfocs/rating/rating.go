@@ -0,0 +1,148 @@
+// Package rating computes performance ratings of players based on the statistics of the stats package.
+//
+// The rating formula is pluggable via the Formula interface:
+//   - HLTV1 implements the HLTV.org Rating 1.0.
+//   - Weighted is a linear combination of KPR, DPR, ADR, KAST and impact with configurable weights,
+//     HLTV2Approximation is a preset approximating the HLTV.org Rating 2.0.
+//
+// Example:
+//
+//	agg := stats.NewAggregator(parser)
+//	parser.ParseToEnd()
+//
+//	for _, r := range rating.Ratings(agg, rating.HLTV1{}) {
+//		fmt.Printf("%s: %.2f\n", r.Name, r.Rating)
+//	}
+package rating
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/stats"
+)
+
+// Formula computes the rating of a player.
+type Formula interface {
+	// Name returns a human readable name of the formula, e.g. "HLTV 1.0".
+	Name() string
+
+	// Rate returns the rating for the given input.
+	Rate(in Input) float64
+}
+
+// Input contains the statistics of a player that are available to rating formulas.
+type Input struct {
+	Rounds       int // Rounds played
+	Kills        int
+	Deaths       int
+	Assists      int // Not including flash assists
+	FlashAssists int
+	Damage       int // Health damage dealt to enemies, capped at their remaining health
+	KASTRounds   int
+	TradeKills   int
+	OpeningKills int
+
+	// Maps the number of kills (0 - 5) to the number of rounds played with that many kills.
+	// Rounds with more than 5 kills (e.g. with bots taken over) are counted as 5.
+	RoundsWithKills map[int]int
+}
+
+// Impact rating weights, a community approximation of the impact term of the HLTV.org Rating 2.0.
+const (
+	impactKPRWeight = 2.13
+	impactAPRWeight = 0.42
+	impactIntercept = -0.41
+)
+
+// KPR returns the kills per round, 0 if no rounds were played.
+func (in Input) KPR() float64 {
+	return in.perRound(in.Kills)
+}
+
+// DPR returns the deaths per round, 0 if no rounds were played.
+func (in Input) DPR() float64 {
+	return in.perRound(in.Deaths)
+}
+
+// APR returns the assists (not including flash assists) per round, 0 if no rounds were played.
+func (in Input) APR() float64 {
+	return in.perRound(in.Assists)
+}
+
+// ADR returns the average damage per round, 0 if no rounds were played.
+func (in Input) ADR() float64 {
+	return in.perRound(in.Damage)
+}
+
+// KAST returns the percentage (0 - 100) of rounds with a kill, assist, survival or traded death.
+func (in Input) KAST() float64 {
+	return 100 * in.perRound(in.KASTRounds)
+}
+
+// Impact returns the impact rating, approximated from the kills and assists per round.
+//
+//	Impact = 2.13 * KPR + 0.42 * APR - 0.41
+//
+// Returns 0 if no rounds were played.
+func (in Input) Impact() float64 {
+	if in.Rounds == 0 {
+		return 0
+	}
+
+	return impactKPRWeight*in.KPR() + impactAPRWeight*in.APR() + impactIntercept
+}
+
+func (in Input) perRound(n int) float64 {
+	if in.Rounds == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(in.Rounds)
+}
+
+// InputOf returns the rating input of a single player.
+func InputOf(agg *stats.Aggregator, key stats.PlayerKey) Input {
+	ps := agg.Player(key)
+
+	in := Input{
+		Rounds:          ps.RoundsPlayed,
+		Kills:           ps.Kills,
+		Deaths:          ps.Deaths,
+		Assists:         ps.Assists,
+		FlashAssists:    ps.FlashAssists,
+		Damage:          ps.Damage,
+		KASTRounds:      ps.KASTRounds,
+		TradeKills:      ps.TradeKills,
+		OpeningKills:    ps.OpeningKills,
+		RoundsWithKills: make(map[int]int),
+	}
+
+	for _, round := range agg.Rounds() {
+		if prs := round.Player(key); prs != nil && prs.Played {
+			in.RoundsWithKills[min(prs.Kills, 5)]++
+		}
+	}
+
+	return in
+}
+
+// PlayerRating is the rating of a single player.
+type PlayerRating struct {
+	Key    stats.PlayerKey
+	Name   string
+	Rating float64
+}
+
+// Ratings returns the ratings of all players of the aggregator, in the same order as Aggregator.Players().
+func Ratings(agg *stats.Aggregator, formula Formula) []PlayerRating {
+	players := agg.Players()
+	res := make([]PlayerRating, 0, len(players))
+
+	for _, ps := range players {
+		res = append(res, PlayerRating{
+			Key:    ps.Key,
+			Name:   ps.Name,
+			Rating: formula.Rate(InputOf(agg, ps.Key)),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,57 @@
+package rating
+
+// Weighted is a linear rating formula with configurable weights.
+//
+//	Rating = Intercept + KPR * KPRWeight + DPR * DPRWeight + ADR * ADRWeight + KAST * KASTWeight + Impact * ImpactWeight
+//
+// See Input.KPR(), Input.DPR(), Input.ADR(), Input.KAST() and Input.Impact() for the individual terms.
+// Returns 0 if the player didn't play any rounds.
+//
+// Unlike HLTV1, which uses fixed averages, Weighted can be tuned to any weighting of the terms,
+// e.g. HLTV2Approximation.
+type Weighted struct {
+	Label string // Returned by Name(), "Weighted" if empty
+
+	Intercept    float64
+	KPRWeight    float64
+	DPRWeight    float64
+	ADRWeight    float64
+	KASTWeight   float64 // KAST is in percent (0 - 100)
+	ImpactWeight float64
+}
+
+// HLTV2Approximation is a community approximation of the HLTV.org Rating 2.0, whose exact formula isn't public.
+//
+//	Rating = 0.0073 * KAST + 0.3591 * KPR - 0.5329 * DPR + 0.2372 * Impact + 0.0032 * ADR + 0.1587
+var HLTV2Approximation = Weighted{
+	Label:        "HLTV 2.0 (approximation)",
+	Intercept:    0.1587,
+	KPRWeight:    0.3591,
+	DPRWeight:    -0.5329,
+	ADRWeight:    0.0032,
+	KASTWeight:   0.0073,
+	ImpactWeight: 0.2372,
+}
+
+// Name returns the label of the formula or "Weighted" if it has none.
+func (w Weighted) Name() string {
+	if w.Label == "" {
+		return "Weighted"
+	}
+
+	return w.Label
+}
+
+// Rate returns the weighted rating for the given input.
+func (w Weighted) Rate(in Input) float64 {
+	if in.Rounds == 0 {
+		return 0
+	}
+
+	return w.Intercept +
+		w.KPRWeight*in.KPR() +
+		w.DPRWeight*in.DPR() +
+		w.ADRWeight*in.ADR() +
+		w.KASTWeight*in.KAST() +
+		w.ImpactWeight*in.Impact()
+}
@@ -0,0 +1,263 @@
+package demoinfocs
+
+import (
+	common "github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// bindRounds keeps track of the rounds of the match, see GameState.Rounds().
+// The handlers are registered before any user handlers so the rounds are up-to-date when users receive the events.
+func (p *parser) bindRounds() {
+	gs := p.gameState
+
+	// TeamSideSwitch may be dispatched before the first round of the new half starts
+	sideSwitchPending := false
+
+	p.eventDispatcher.RegisterHandler(func(events.RoundStart) {
+		if gs.isWarmupPeriod {
+			return
+		}
+
+		number := gs.totalRoundsPlayed + 1
+
+		// mp_restartgame or a restored round backup - forget about the rounds that are being replayed
+		for len(gs.rounds) > 0 && gs.rounds[len(gs.rounds)-1].Number >= number {
+			gs.rounds = gs.rounds[:len(gs.rounds)-1]
+		}
+
+		round := common.NewRound(number, gs.ingameTick)
+		round.OvertimeNumber = gs.overtimeCount
+		round.SidesSwitched = sideSwitchPending
+		sideSwitchPending = false
+
+		gs.updateRoundTeams(round)
+
+		gs.rounds = append(gs.rounds, round)
+		gs.updateRoundHalf(round)
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.GamePhaseChanged) {
+		if e.NewGamePhase == common.GamePhaseGameEnded {
+			gs.matchEndTick = gs.ingameTick
+		} else if e.OldGamePhase == common.GamePhaseGameEnded {
+			// mp_restartgame after the match ended
+			gs.matchEndTick = -1
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(events.RoundFreezetimeEnd) {
+		round := gs.CurrentRound()
+		if round == nil || round.HasEnded() {
+			return
+		}
+
+		round.FreezetimeEndTick = gs.ingameTick
+		gs.updateRoundTeams(round)
+	})
+
+	p.eventDispatcher.RegisterHandler(func(events.TeamSideSwitch) {
+		round := gs.CurrentRound()
+		if round == nil || round.HasEnded() || round.FreezetimeEndTick != -1 {
+			sideSwitchPending = true
+
+			return
+		}
+
+		round.SidesSwitched = true
+		gs.updateRoundTeams(round)
+		gs.updateRoundHalf(round)
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.OvertimeNumberChanged) {
+		if round := gs.CurrentRound(); round != nil && !round.HasEnded() {
+			round.OvertimeNumber = e.NewCount
+			gs.updateRoundHalf(round)
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.RoundEnd) {
+		round := gs.CurrentRound()
+		if round == nil || round.HasEnded() {
+			return
+		}
+
+		round.EndTick = gs.ingameTick
+		round.Winner = e.Winner
+		round.EndReason = e.Reason
+		round.EndMessage = e.Message
+
+		// the score may not be updated yet, see events.RoundEnd
+		if winner := round.Team(e.Winner); winner != nil && winner.ScoreAfter == winner.ScoreBefore {
+			winner.ScoreAfter++
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.ScoreUpdated) {
+		round := gs.CurrentRound()
+		if round == nil || round.OfficialEndTick != -1 || e.TeamState == nil {
+			return
+		}
+
+		team := round.Team(e.TeamState.Team())
+		if team != nil && e.NewScore >= team.ScoreBefore {
+			team.ScoreAfter = e.NewScore
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(events.RoundEndOfficial) {
+		if round := gs.CurrentRound(); round != nil && round.OfficialEndTick == -1 {
+			round.OfficialEndTick = gs.ingameTick
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombPlanted) {
+		if round := gs.CurrentRound(); round != nil {
+			round.BombPlant = gs.newRoundBombEvent(e.BombEvent)
+			gs.addBombTimelineEntry(round, common.BombActionPlanted, e.Player, e.Site)
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombDefused) {
+		if round := gs.CurrentRound(); round != nil {
+			round.BombDefuse = gs.newRoundBombEvent(e.BombEvent)
+			gs.addBombTimelineEntry(round, common.BombActionDefused, e.Player, e.Site)
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombExplode) {
+		if round := gs.CurrentRound(); round != nil {
+			round.BombExplode = gs.newRoundBombEvent(e.BombEvent)
+			gs.addBombTimelineEntry(round, common.BombActionExploded, e.Player, e.Site)
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombOwnerUpdate) {
+		round := gs.CurrentRound()
+		if round == nil {
+			return
+		}
+
+		if e.PrevOwner != nil && e.PrevOwner != e.NewOwner {
+			gs.addBombTimelineEntry(round, common.BombActionDrop, e.PrevOwner, common.BomsiteUnknown)
+		}
+
+		if e.NewOwner != nil && e.PrevOwner != e.NewOwner {
+			gs.addBombTimelineEntry(round, common.BombActionPickup, e.NewOwner, common.BomsiteUnknown)
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombPlantBegin) {
+		if round := gs.CurrentRound(); round != nil {
+			gs.addBombTimelineEntry(round, common.BombActionPlantBegin, e.Player, e.Site)
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombPlantAborted) {
+		if round := gs.CurrentRound(); round != nil {
+			gs.addBombTimelineEntry(round, common.BombActionPlantAbort, e.Player, lastBombSite(round))
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombDefuseStart) {
+		if round := gs.CurrentRound(); round != nil {
+			gs.addBombTimelineEntry(round, common.BombActionDefuseBegin, e.Player, lastBombSite(round))
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.BombDefuseAborted) {
+		if round := gs.CurrentRound(); round != nil {
+			gs.addBombTimelineEntry(round, common.BombActionDefuseAbort, e.Player, lastBombSite(round))
+		}
+	})
+
+	p.eventDispatcher.RegisterHandler(func(e events.Kill) {
+		round := gs.CurrentRound()
+		if round == nil {
+			return
+		}
+
+		round.Kills = append(round.Kills, &common.RoundKill{
+			Tick:              gs.ingameTick,
+			Killer:            e.Killer,
+			Victim:            e.Victim,
+			Assister:          e.Assister,
+			Weapon:            e.Weapon,
+			IsHeadshot:        e.IsHeadshot,
+			AssistedFlash:     e.AssistedFlash,
+			PenetratedObjects: e.PenetratedObjects,
+			ThroughSmoke:      e.ThroughSmoke,
+		})
+	})
+}
+
+// updateRoundTeams updates the clan names and scores of both sides of a round that hasn't ended yet.
+// Clan names and scores may be swapped after the round started because of a side switch.
+func (gs *gameState) updateRoundTeams(round *common.Round) {
+	for _, team := range []common.Team{common.TeamCounterTerrorists, common.TeamTerrorists} {
+		state := gs.Team(team)
+		score := state.Score()
+
+		roundTeam := round.Team(team)
+		roundTeam.ClanName = state.ClanName()
+		roundTeam.ScoreBefore = score
+		roundTeam.ScoreAfter = score
+	}
+}
+
+// updateRoundHalf updates the half of the current round.
+// A new half starts after a side switch and at the start of each overtime.
+func (gs *gameState) updateRoundHalf(round *common.Round) {
+	if len(gs.rounds) < 2 {
+		round.Half = 1
+
+		return
+	}
+
+	prev := gs.rounds[len(gs.rounds)-2]
+	round.Half = prev.Half
+
+	if round.SidesSwitched || round.OvertimeNumber != prev.OvertimeNumber {
+		round.Half++
+	}
+}
+
+func (gs *gameState) newRoundBombEvent(e events.BombEvent) *common.RoundBombEvent {
+	return &common.RoundBombEvent{
+		Tick:     gs.ingameTick,
+		Player:   e.Player,
+		Site:     e.Site,
+		Position: gs.bomb.Position(),
+	}
+}
+
+// addBombTimelineEntry adds an entry to the bomb timeline of the round.
+// The position is the one of the player for defuse actions (the bomb doesn't move) and the bomb's position otherwise.
+func (gs *gameState) addBombTimelineEntry(round *common.Round, action common.BombAction, player *common.Player, site common.Bombsite) {
+	pos := gs.bomb.Position()
+
+	isDefuseAction := action == common.BombActionDefuseBegin || action == common.BombActionDefuseAbort
+	if isDefuseAction && player != nil {
+		pos = player.Position()
+	}
+
+	round.BombTimeline = append(round.BombTimeline, common.BombTimelineEntry{
+		Action: action,
+		RoundBombEvent: common.RoundBombEvent{
+			Tick:     gs.ingameTick,
+			Player:   player,
+			Site:     site,
+			Position: pos,
+		},
+	})
+}
+
+// lastBombSite returns the site of the last plant (attempt) of the round.
+func lastBombSite(round *common.Round) common.Bombsite {
+	for i := len(round.BombTimeline) - 1; i >= 0; i-- {
+		if site := round.BombTimeline[i].Site; site != common.BomsiteUnknown {
+			return site
+		}
+	}
+
+	return common.BomsiteUnknown
+}
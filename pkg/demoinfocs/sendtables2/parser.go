@@ -107,6 +107,16 @@ func (p *Parser) ServerClasses() st.ServerClasses {
 	return (*serverClasses)(p)
 }
 
+// ServerClassByID returns the server-class with the given ID or nil if it doesn't exist.
+func (p *Parser) ServerClassByID(id int) st.ServerClass {
+	class := p.classesById[int32(id)]
+	if class == nil {
+		return nil
+	}
+
+	return class
+}
+
 func (p *Parser) Entities() map[int32]*Entity {
 	return p.entities
 }
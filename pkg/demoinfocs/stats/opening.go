@@ -0,0 +1,115 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// OpeningDuel contains information about the first kill of a round.
+// Rounds in which the first kill was a team kill, a suicide or world damage don't have an opening duel.
+type OpeningDuel struct {
+	Round         int
+	Tick          int
+	Attacker      *common.Player // Credited player, the controlling human for bots that have been taken over
+	Victim        *common.Player
+	Weapon        *common.Equipment
+	AttackerSide  common.Team
+	VictimSide    common.Team
+	AttackerPlace string        // See Player.LastPlaceName()
+	VictimPlace   string        // See Player.LastPlaceName(), used as area of the duel
+	TimeIntoRound time.Duration // Time since the end of the freeze time, see GameState.LastFreezeEnd()
+
+	AttackerTeamWon bool // Set at the end of the round
+}
+
+// AreaOpeningStats contains the outcomes of the opening duels in a single area of the map.
+type AreaOpeningStats struct {
+	Area           string // See Player.LastPlaceName(), may be empty if the area is unknown
+	Duels          int
+	WonByT         int
+	WonByCT        int
+	RoundsWonAfter int // Rounds won by the team of the attacker
+}
+
+// TWinRate returns the fraction (0 - 1) of opening duels in the area that were won by Terrorists.
+func (as *AreaOpeningStats) TWinRate() float64 {
+	if as.Duels == 0 {
+		return 0
+	}
+
+	return float64(as.WonByT) / float64(as.Duels)
+}
+
+// ConversionRate returns the fraction (0 - 1) of opening duels in the area after which the attacker's team won the round.
+func (as *AreaOpeningStats) ConversionRate() float64 {
+	if as.Duels == 0 {
+		return 0
+	}
+
+	return float64(as.RoundsWonAfter) / float64(as.Duels)
+}
+
+// OpeningDuels returns the opening duels of all rounds so far.
+func (agg *Aggregator) OpeningDuels() []*OpeningDuel {
+	var res []*OpeningDuel
+
+	for _, round := range agg.rounds {
+		if round.OpeningDuel != nil {
+			res = append(res, round.OpeningDuel)
+		}
+	}
+
+	return res
+}
+
+// OpeningStatsByArea returns the outcomes of all opening duels so far, grouped by the area of the victim.
+func (agg *Aggregator) OpeningStatsByArea() map[string]*AreaOpeningStats {
+	res := make(map[string]*AreaOpeningStats)
+
+	for _, duel := range agg.OpeningDuels() {
+		as, ok := res[duel.VictimPlace]
+		if !ok {
+			as = &AreaOpeningStats{Area: duel.VictimPlace}
+			res[duel.VictimPlace] = as
+		}
+
+		as.Duels++
+
+		switch duel.AttackerSide {
+		case common.TeamTerrorists:
+			as.WonByT++
+		case common.TeamCounterTerrorists:
+			as.WonByCT++
+		}
+
+		if duel.AttackerTeamWon {
+			as.RoundsWonAfter++
+		}
+	}
+
+	return res
+}
+
+func (agg *Aggregator) newOpeningDuel(round *RoundStats, attacker, victim *common.Player, weapon *common.Equipment) *OpeningDuel {
+	gs := agg.parser.GameState()
+
+	duel := &OpeningDuel{
+		Round:         round.Number,
+		Tick:          gs.IngameTick(),
+		Attacker:      attacker,
+		Victim:        victim,
+		Weapon:        weapon,
+		AttackerSide:  attacker.Team,
+		VictimSide:    victim.Team,
+		AttackerPlace: attacker.LastPlaceName(),
+		VictimPlace:   victim.LastPlaceName(),
+	}
+
+	if freezeEnd := gs.LastFreezeEnd(); freezeEnd >= 0 && freezeEnd <= duel.Tick {
+		tickRate := common.TickRateOrDefault(agg.parser.TickRate())
+		duel.TimeIntoRound = time.Duration(float64(duel.Tick-freezeEnd) / tickRate * float64(time.Second))
+	}
+
+	return duel
+}
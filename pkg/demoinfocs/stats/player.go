@@ -0,0 +1,177 @@
+package stats
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// PlayerStats contains the statistics of a single player for the whole match.
+type PlayerStats struct {
+	Key    PlayerKey
+	Player *common.Player // Last known instance of the player, see also Key
+	Name   string
+
+	RoundsPlayed   int
+	Kills          int
+	Deaths         int
+	Assists        int // Not including flash assists
+	FlashAssists   int
+	HeadshotKills  int
+	TeamKills      int
+	Damage         int // Health damage dealt to enemies, capped at their remaining health
+	KASTRounds     int // Rounds with a kill, assist, survival or traded death (see PlayerRoundStats.KAST())
+	OpeningKills   int
+	OpeningDeaths  int
+	OpeningWins    int // Rounds won after getting the opening kill
+	RoundsSurvived int
+	TradeKills     int
+	TradedDeaths   int
+	MultiKills     map[int]int // Maps the number of kills (2 - 5) to the number of rounds with that many kills
+
+	Clutches            ClutchStats
+	ClutchesByOpponents map[int]ClutchStats // Maps the number of opponents at the start of the clutch to the clutches against that many
+}
+
+// ClutchStats contains the outcomes of a player's clutch situations, see common.Clutch.
+type ClutchStats struct {
+	Attempts int
+	Won      int
+	Saved    int // Survived but lost the round
+	Kills    int // Enemies killed during the clutches
+}
+
+// WinRate returns the fraction (0 - 1) of clutches that were won.
+func (cs ClutchStats) WinRate() float64 {
+	if cs.Attempts == 0 {
+		return 0
+	}
+
+	return float64(cs.Won) / float64(cs.Attempts)
+}
+
+func (cs ClutchStats) add(c *common.Clutch) ClutchStats {
+	cs.Attempts++
+	cs.Kills += c.Kills
+
+	if c.Won {
+		cs.Won++
+	}
+
+	if c.Saved {
+		cs.Saved++
+	}
+
+	return cs
+}
+
+// ADR returns the average damage per round.
+func (ps *PlayerStats) ADR() float64 {
+	return ps.perRound(ps.Damage)
+}
+
+// KAST returns the fraction (0 - 1) of rounds in which the player got a kill, an assist, survived or was traded.
+func (ps *PlayerStats) KAST() float64 {
+	return ps.perRound(ps.KASTRounds)
+}
+
+// OpeningSuccessRate returns the fraction (0 - 1) of opening duels the player took part in that they won.
+func (ps *PlayerStats) OpeningSuccessRate() float64 {
+	if ps.OpeningKills+ps.OpeningDeaths == 0 {
+		return 0
+	}
+
+	return float64(ps.OpeningKills) / float64(ps.OpeningKills+ps.OpeningDeaths)
+}
+
+// OpeningConversionRate returns the fraction (0 - 1) of the player's opening kills after which their team won the round.
+func (ps *PlayerStats) OpeningConversionRate() float64 {
+	if ps.OpeningKills == 0 {
+		return 0
+	}
+
+	return float64(ps.OpeningWins) / float64(ps.OpeningKills)
+}
+
+// KPR returns the average number of kills per round.
+func (ps *PlayerStats) KPR() float64 {
+	return ps.perRound(ps.Kills)
+}
+
+// DPR returns the average number of deaths per round.
+func (ps *PlayerStats) DPR() float64 {
+	return ps.perRound(ps.Deaths)
+}
+
+// KDRatio returns kills divided by deaths, or the number of kills if the player never died.
+func (ps *PlayerStats) KDRatio() float64 {
+	if ps.Deaths == 0 {
+		return float64(ps.Kills)
+	}
+
+	return float64(ps.Kills) / float64(ps.Deaths)
+}
+
+// HeadshotPercentage returns the fraction (0 - 1) of kills that were headshots.
+func (ps *PlayerStats) HeadshotPercentage() float64 {
+	if ps.Kills == 0 {
+		return 0
+	}
+
+	return float64(ps.HeadshotKills) / float64(ps.Kills)
+}
+
+func (ps *PlayerStats) perRound(n int) float64 {
+	if ps.RoundsPlayed == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(ps.RoundsPlayed)
+}
+
+func (ps *PlayerStats) add(prs *PlayerRoundStats) {
+	if prs.Player != nil {
+		ps.Name = prs.Player.Name
+	}
+
+	if prs.Played {
+		ps.RoundsPlayed++
+	}
+
+	ps.Kills += prs.Kills
+	ps.Deaths += prs.Deaths
+	ps.Assists += prs.Assists
+	ps.FlashAssists += prs.FlashAssists
+	ps.HeadshotKills += prs.HeadshotKills
+	ps.TeamKills += prs.TeamKills
+	ps.Damage += prs.Damage
+
+	if prs.KAST() {
+		ps.KASTRounds++
+	}
+
+	if prs.OpeningKill {
+		ps.OpeningKills++
+	}
+
+	if prs.OpeningDeath {
+		ps.OpeningDeaths++
+	}
+
+	if prs.Survived {
+		ps.RoundsSurvived++
+	}
+
+	ps.TradeKills += prs.TradeKills
+
+	if prs.TradedDeath {
+		ps.TradedDeaths++
+	}
+
+	if prs.Kills >= 2 {
+		ps.MultiKills[prs.Kills]++
+	}
+
+	if prs.Clutch != nil {
+		ps.Clutches = ps.Clutches.add(prs.Clutch)
+		ps.ClutchesByOpponents[prs.Clutch.Opponents] = ps.ClutchesByOpponents[prs.Clutch.Opponents].add(prs.Clutch)
+	}
+}
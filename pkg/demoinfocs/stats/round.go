@@ -0,0 +1,58 @@
+package stats
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// RoundStats contains the statistics of all players during a single round.
+type RoundStats struct {
+	Number    int
+	Winner    common.Team // TeamUnassigned while the round is in progress
+	EndReason common.RoundEndReason
+	Players   map[PlayerKey]*PlayerRoundStats
+
+	OpeningDuel *OpeningDuel // nil if there was no (enemy) kill yet
+
+	hasKills bool
+}
+
+// Player returns the statistics of the given player during the round or nil if the player didn't take part in it.
+func (rs *RoundStats) Player(key PlayerKey) *PlayerRoundStats {
+	return rs.Players[key]
+}
+
+// PlayerRoundStats contains the statistics of a single player during a single round.
+type PlayerRoundStats struct {
+	Key    PlayerKey
+	Player *common.Player // Last known instance of the player
+	Team   common.Team    // Side the player played on during the round
+
+	Played        bool // True if the player was on T or CT at the end of the freeze time or the end of the round
+	Kills         int  // Enemies killed
+	Deaths        int
+	Assists       int // Not including flash assists
+	FlashAssists  int
+	HeadshotKills int
+	TeamKills     int
+	Damage        int // Health damage dealt to enemies, capped at their remaining health
+	OpeningKill   bool
+	OpeningDeath  bool
+	Survived      bool
+	TradeKills    int  // Kills that traded the death of a teammate, see events.TradeKill
+	TradedDeath   bool // True if the player's death was traded by a teammate
+	TookOverBot   bool
+
+	Clutch *common.Clutch // The player's clutch situation ("1vX"), nil if there wasn't one
+}
+
+// KAST returns true if the player got a kill, an assist, survived the round or their death was traded.
+func (prs *PlayerRoundStats) KAST() bool {
+	return prs.Kills > 0 || prs.Assists > 0 || prs.FlashAssists > 0 || prs.Survived || prs.TradedDeath
+}
+
+func newRoundStats(round *common.Round) *RoundStats {
+	return &RoundStats{
+		Number:  round.Number,
+		Players: make(map[PlayerKey]*PlayerRoundStats),
+	}
+}
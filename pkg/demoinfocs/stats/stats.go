@@ -0,0 +1,325 @@
+// Package stats provides a scoreboard-like aggregation of player statistics per round and for the whole match.
+//
+// An Aggregator attaches to a parser and collects kills, deaths, assists, damage etc. for every player.
+// The results are plain structs that can be retrieved at any time, usually after Parser.ParseToEnd().
+//
+// Example:
+//
+//	agg := stats.NewAggregator(parser)
+//	parser.ParseToEnd()
+//
+//	for _, ps := range agg.Players() {
+//		fmt.Printf("%s: %d/%d/%d ADR %.1f KAST %.0f%%\n", ps.Name, ps.Kills, ps.Deaths, ps.Assists, ps.ADR(), ps.KAST()*100)
+//	}
+//
+// Players are identified by their SteamID64 so reconnects don't split their stats, bots by their UserID (see PlayerKey).
+// Kills, assists and damage of a bot that has been taken over by a human are credited to the human.
+// Deaths are always counted for the player (or bot) that actually died.
+//
+// Rounds are the ones of GameState().Rounds(), so warmup rounds and rounds reverted by a restart / round backup are excluded.
+package stats
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// maxHealth is the health of players at the start of a round.
+const maxHealth = 100
+
+// PlayerKey identifies a player across reconnects.
+// Humans are identified by their SteamID64, bots (which don't have one) by their UserID.
+type PlayerKey struct {
+	SteamID64 uint64
+	UserID    int // Only set for bots
+}
+
+// KeyOf returns the PlayerKey of the given player.
+func KeyOf(pl *common.Player) PlayerKey {
+	if pl.IsBot || pl.SteamID64 == 0 {
+		return PlayerKey{UserID: pl.UserID}
+	}
+
+	return PlayerKey{SteamID64: pl.SteamID64}
+}
+
+// Aggregator collects statistics of all players during parsing.
+type Aggregator struct {
+	parser demoinfocs.Parser
+
+	rounds      []*RoundStats
+	playerOrder []PlayerKey
+	players     map[PlayerKey]*common.Player // Last known instance of each player
+	health      map[PlayerKey]int            // Remaining health of each player in the current round
+
+	lastTradeKill *common.RoundKill // A kill may trade multiple deaths but should only be counted once
+}
+
+// NewAggregator creates an Aggregator and registers its event handlers on the parser.
+// All events dispatched after this call are taken into account.
+func NewAggregator(parser demoinfocs.Parser) *Aggregator {
+	agg := &Aggregator{
+		parser:  parser,
+		players: make(map[PlayerKey]*common.Player),
+		health:  make(map[PlayerKey]int),
+	}
+
+	parser.RegisterEventHandler(agg.roundStart)
+	parser.RegisterEventHandler(agg.roundFreezetimeEnd)
+	parser.RegisterEventHandler(agg.playerHurt)
+	parser.RegisterEventHandler(agg.kill)
+	parser.RegisterEventHandler(agg.tradeKill)
+	parser.RegisterEventHandler(agg.clutchStart)
+	parser.RegisterEventHandler(agg.botTakenOver)
+	parser.RegisterEventHandler(agg.roundEnd)
+
+	return agg
+}
+
+// Rounds returns the statistics of all rounds so far.
+func (agg *Aggregator) Rounds() []*RoundStats {
+	return agg.rounds
+}
+
+// Players returns the statistics of all players for the whole match, in order of their first appearance.
+func (agg *Aggregator) Players() []*PlayerStats {
+	res := make([]*PlayerStats, 0, len(agg.playerOrder))
+
+	for _, key := range agg.playerOrder {
+		if ps := agg.Player(key); ps.RoundsPlayed > 0 || ps.Kills > 0 || ps.Deaths > 0 {
+			res = append(res, ps)
+		}
+	}
+
+	return res
+}
+
+// Player returns the statistics of a single player for the whole match.
+func (agg *Aggregator) Player(key PlayerKey) *PlayerStats {
+	ps := &PlayerStats{
+		Key:        key,
+		Player:     agg.players[key],
+		MultiKills: make(map[int]int),
+
+		ClutchesByOpponents: make(map[int]ClutchStats),
+	}
+
+	if ps.Player != nil {
+		ps.Name = ps.Player.Name
+	}
+
+	for _, round := range agg.rounds {
+		if prs, ok := round.Players[key]; ok {
+			ps.add(prs)
+
+			if prs.OpeningKill && round.OpeningDuel != nil && round.OpeningDuel.AttackerTeamWon {
+				ps.OpeningWins++
+			}
+		}
+	}
+
+	return ps
+}
+
+func (agg *Aggregator) currentRound() *RoundStats {
+	round := agg.parser.GameState().CurrentRound()
+	if round == nil || len(agg.rounds) == 0 {
+		return nil
+	}
+
+	last := agg.rounds[len(agg.rounds)-1]
+	if last.Number != round.Number {
+		return nil
+	}
+
+	return last
+}
+
+// playerRound returns the stats of the player in the given round, creating them if necessary.
+func (agg *Aggregator) playerRound(round *RoundStats, pl *common.Player) *PlayerRoundStats {
+	key := KeyOf(pl)
+
+	if _, known := agg.players[key]; !known {
+		agg.playerOrder = append(agg.playerOrder, key)
+	}
+
+	agg.players[key] = pl
+
+	prs, ok := round.Players[key]
+	if !ok {
+		prs = &PlayerRoundStats{
+			Key:  key,
+			Team: pl.Team,
+		}
+		round.Players[key] = prs
+	}
+
+	prs.Player = pl
+
+	return prs
+}
+
+// creditedPlayer returns the player that should be credited for the actions of the given player.
+// This is the controlling human for bots that have been taken over.
+func creditedPlayer(pl *common.Player) *common.Player {
+	if pl == nil || !pl.IsBot {
+		return pl
+	}
+
+	return pl.Controller()
+}
+
+func (agg *Aggregator) roundStart(events.RoundStart) {
+	round := agg.parser.GameState().CurrentRound()
+	if round == nil || round.StartTick != agg.parser.GameState().IngameTick() {
+		// no new round was started, e.g. during the warmup
+		return
+	}
+
+	// mp_restartgame or a restored round backup
+	for len(agg.rounds) > 0 && agg.rounds[len(agg.rounds)-1].Number >= round.Number {
+		agg.rounds = agg.rounds[:len(agg.rounds)-1]
+	}
+
+	agg.rounds = append(agg.rounds, newRoundStats(round))
+	agg.health = make(map[PlayerKey]int)
+}
+
+func (agg *Aggregator) roundFreezetimeEnd(events.RoundFreezetimeEnd) {
+	round := agg.currentRound()
+	if round == nil {
+		return
+	}
+
+	for _, pl := range agg.parser.GameState().Participants().Playing() {
+		if pl.Team == common.TeamTerrorists || pl.Team == common.TeamCounterTerrorists {
+			agg.playerRound(round, pl).Played = true
+		}
+	}
+}
+
+func (agg *Aggregator) playerHurt(e events.PlayerHurt) {
+	if e.Player == nil {
+		return
+	}
+
+	victimKey := KeyOf(e.Player)
+
+	healthBefore, ok := agg.health[victimKey]
+	if !ok {
+		healthBefore = min(e.Health+e.HealthDamage, maxHealth)
+	}
+
+	agg.health[victimKey] = e.Health
+
+	round := agg.currentRound()
+	attacker := creditedPlayer(e.Attacker)
+
+	if round == nil || attacker == nil || attacker == e.Player || attacker.Team == e.Player.Team {
+		return
+	}
+
+	agg.playerRound(round, attacker).Damage += min(e.HealthDamage, max(healthBefore, 0))
+}
+
+func (agg *Aggregator) kill(e events.Kill) {
+	round := agg.currentRound()
+	if round == nil || e.Victim == nil {
+		return
+	}
+
+	victim := agg.playerRound(round, e.Victim)
+	victim.Deaths++
+
+	killer := creditedPlayer(e.Killer)
+	isEnemyKill := killer != nil && killer != e.Victim && killer.Team != e.Victim.Team
+
+	if isEnemyKill {
+		prs := agg.playerRound(round, killer)
+		prs.Kills++
+
+		if e.IsHeadshot {
+			prs.HeadshotKills++
+		}
+
+		if !round.hasKills {
+			prs.OpeningKill = true
+			victim.OpeningDeath = true
+			round.OpeningDuel = agg.newOpeningDuel(round, killer, e.Victim, e.Weapon)
+		}
+	} else if killer != nil && killer != e.Victim {
+		agg.playerRound(round, killer).TeamKills++
+	}
+
+	round.hasKills = true
+
+	if assister := creditedPlayer(e.Assister); assister != nil && assister.Team != e.Victim.Team {
+		prs := agg.playerRound(round, assister)
+
+		if e.AssistedFlash {
+			prs.FlashAssists++
+		} else {
+			prs.Assists++
+		}
+	}
+}
+
+func (agg *Aggregator) tradeKill(e events.TradeKill) {
+	round := agg.currentRound()
+	if round == nil {
+		return
+	}
+
+	if killer := creditedPlayer(e.Killer); killer != nil && e.Kill != agg.lastTradeKill {
+		agg.playerRound(round, killer).TradeKills++
+	}
+
+	agg.lastTradeKill = e.Kill
+
+	if e.TradedPlayer != nil {
+		agg.playerRound(round, e.TradedPlayer).TradedDeath = true
+	}
+}
+
+func (agg *Aggregator) clutchStart(e events.ClutchStart) {
+	round := agg.currentRound()
+	if round == nil || e.Player == nil {
+		return
+	}
+
+	agg.playerRound(round, creditedPlayer(e.Player)).Clutch = e.Clutch
+}
+
+func (agg *Aggregator) botTakenOver(e events.BotTakenOver) {
+	round := agg.currentRound()
+	if round == nil || e.Taker == nil {
+		return
+	}
+
+	agg.playerRound(round, e.Taker).TookOverBot = true
+}
+
+func (agg *Aggregator) roundEnd(e events.RoundEnd) {
+	round := agg.currentRound()
+	if round == nil || round.Winner != common.TeamUnassigned {
+		return
+	}
+
+	round.Winner = e.Winner
+	round.EndReason = e.Reason
+
+	if round.OpeningDuel != nil {
+		round.OpeningDuel.AttackerTeamWon = round.OpeningDuel.AttackerSide == e.Winner
+	}
+
+	for _, pl := range agg.parser.GameState().Participants().Playing() {
+		if pl.Team != common.TeamTerrorists && pl.Team != common.TeamCounterTerrorists {
+			continue
+		}
+
+		prs := agg.playerRound(round, pl)
+		prs.Played = true
+		prs.Survived = prs.Deaths == 0 && pl.IsAlive()
+	}
+}
@@ -0,0 +1,91 @@
+package demoinfocs
+
+import (
+	"math"
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// DefaultTradeWindow is the default maximum time between two kills for the second one to count as a trade.
+// See ParserConfig.TradeWindow.
+const DefaultTradeWindow = 5 * time.Second
+
+// bindTrades detects kills that trade the death of a teammate, see events.TradeKill.
+// Must be registered after bindRounds() since it relies on Round.Kills.
+func (p *parser) bindTrades() {
+	gs := p.gameState
+
+	p.eventDispatcher.RegisterHandler(func(e events.Kill) {
+		round := gs.CurrentRound()
+		if round == nil || len(round.Kills) == 0 || e.Killer == nil || e.Victim == nil {
+			return
+		}
+
+		if e.Killer == e.Victim || e.Killer.Team == e.Victim.Team {
+			return
+		}
+
+		kill := round.Kills[len(round.Kills)-1]
+		window := p.tradeWindowTicks()
+
+		var trades []events.TradeKill
+
+		for i := len(round.Kills) - 2; i >= 0; i-- {
+			traded := round.Kills[i]
+			if kill.Tick-traded.Tick > window {
+				break
+			}
+
+			if traded.TradedBy != nil || traded.Killer != e.Victim || traded.Victim == nil {
+				continue
+			}
+
+			if traded.Victim == e.Killer || traded.Victim.Team != e.Killer.Team {
+				continue
+			}
+
+			traded.TradedBy = kill
+			kill.IsTrade = true
+
+			trades = append(trades, events.TradeKill{
+				Killer:       e.Killer,
+				Victim:       e.Victim,
+				TradedPlayer: traded.Victim,
+				Kill:         kill,
+				TradedKill:   traded,
+				Delay:        p.ticksToDuration(kill.Tick - traded.Tick),
+			})
+		}
+
+		if len(trades) == 0 {
+			return
+		}
+
+		p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+			// oldest traded death first
+			for i := len(trades) - 1; i >= 0; i-- {
+				p.gameEventHandler.dispatch(trades[i])
+			}
+		})
+	})
+}
+
+// tradeWindowTicks returns ParserConfig.TradeWindow (or DefaultTradeWindow) in ticks.
+func (p *parser) tradeWindowTicks() int {
+	window := p.tradeWindow
+	if window <= 0 {
+		window = DefaultTradeWindow
+	}
+
+	return int(math.Round(window.Seconds() * p.tradeTickRate()))
+}
+
+func (p *parser) ticksToDuration(ticks int) time.Duration {
+	return time.Duration(float64(ticks) / p.tradeTickRate() * float64(time.Second))
+}
+
+func (p *parser) tradeTickRate() float64 {
+	return common.TickRateOrDefault(p.TickRate())
+}
@@ -0,0 +1,104 @@
+package utility
+
+import (
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+)
+
+// Throw contains the effects of a single thrown grenade.
+// Only the fields matching the grenade's Type are filled, e.g. flash statistics for flashbangs.
+type Throw struct {
+	ProjectileID int64 // See GrenadeProjectile.UniqueID()
+	Projectile   *common.GrenadeProjectile
+	Thrower      *common.Player
+	Type         common.EquipmentType
+	Round        int // 0 if the grenade was thrown outside of a round (e.g. during the warmup)
+	ThrowTick    int
+	DestroyTick  int // -1 while the projectile exists
+
+	// Flashbangs
+	EnemiesFlashed    int
+	TeammatesFlashed  int // Not including the thrower
+	SelfFlashed       bool
+	EnemyBlindTime    time.Duration
+	TeammateBlindTime time.Duration // Not including the thrower
+
+	// HE grenades, molotovs & incendiaries
+	EnemyDamage    int // Health damage as reported by the game
+	TeammateDamage int // Not including the thrower
+	EnemyHits      int // Number of times enemies were hurt, a player may be hurt multiple times by the same fire
+
+	// Molotovs & incendiaries
+	BurnDuration        time.Duration // Time the fire denied the area for, 0 if no fire was started or it's still burning
+	BurnArea            float64       // Area covered by all fires of the inferno in square units, see Fires.Area2D()
+	ExtinguishedBySmoke bool
+
+	// Smokes
+	BlockedKills int // Kills whose line of sight went through the smoke
+}
+
+// IsDestroyed returns true if the projectile doesn't exist anymore.
+// Effects of smokes and fires may outlast the projectile.
+func (t *Throw) IsDestroyed() bool {
+	return t.DestroyTick != -1
+}
+
+func newThrow(proj *common.GrenadeProjectile, round, tick int) *Throw {
+	t := &Throw{
+		ProjectileID: proj.UniqueID(),
+		Projectile:   proj,
+		Thrower:      proj.Thrower,
+		Round:        round,
+		ThrowTick:    tick,
+		DestroyTick:  -1,
+	}
+
+	if proj.WeaponInstance != nil {
+		t.Type = proj.WeaponInstance.Type
+	}
+
+	return t
+}
+
+// UnusedUtility contains the grenades a player still had when they died.
+type UnusedUtility struct {
+	Player   *common.Player
+	Round    int
+	Tick     int
+	Grenades map[common.EquipmentType]int // Number of grenades per type, only types the player had
+	Value    int                          // Total price of the grenades, see common.EquipmentCost
+}
+
+// Count returns the total number of unused grenades.
+func (uu UnusedUtility) Count() (n int) {
+	for _, count := range uu.Grenades {
+		n += count
+	}
+
+	return n
+}
+
+func newUnusedUtility(pl *common.Player, round, tick int) UnusedUtility {
+	uu := UnusedUtility{
+		Player:   pl,
+		Round:    round,
+		Tick:     tick,
+		Grenades: make(map[common.EquipmentType]int),
+	}
+
+	// molotovs and incendiaries share the same ammo slot
+	fireGrenade := common.EqIncendiary
+	if pl.Team == common.TeamTerrorists {
+		fireGrenade = common.EqMolotov
+	}
+
+	for _, eqType := range []common.EquipmentType{common.EqFlash, common.EqSmoke, common.EqHE, fireGrenade, common.EqDecoy} {
+		if count := pl.GetGrenadeAmmo(eqType); count > 0 {
+			uu.Grenades[eqType] = count
+			uu.Value += count * common.EquipmentCost[eqType]
+		}
+	}
+
+	return uu
+}
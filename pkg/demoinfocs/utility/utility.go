@@ -0,0 +1,278 @@
+// Package utility analyses the effectiveness of thrown grenades.
+//
+// An Analyzer attaches to a parser and links the effects of grenades (flashed players, damage, burning areas
+// and smokes blocking kills) to the throw of the grenade, identified by the projectile's UniqueID().
+// Additionally the grenades players still had when they died are recorded (see UnusedUtility).
+//
+// Example:
+//
+//	analyzer := utility.NewAnalyzer(parser)
+//	parser.ParseToEnd()
+//
+//	for _, t := range analyzer.Throws() {
+//		if t.Type == common.EqFlash {
+//			fmt.Printf("%s flashed %d enemies for %s\n", t.Thrower, t.EnemiesFlashed, t.EnemyBlindTime)
+//		}
+//	}
+//
+// Damage of HE grenades is attributed to the last grenade of the attacker that exploded,
+// fire damage to the attacker's inferno that contains the victim (or the attacker's latest inferno).
+package utility
+
+import (
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+)
+
+// Analyzer collects the effects of all grenades during parsing.
+type Analyzer struct {
+	parser demoinfocs.Parser
+
+	throws        []*Throw
+	throwsByID    map[int64]*Throw
+	activeThrows  map[int]*Throw            // Maps entity-IDs of existing projectiles (and smokes) to their throw
+	lastHE        map[*common.Player]*Throw // Last exploded HE grenade of each player
+	unusedUtility []UnusedUtility
+}
+
+// NewAnalyzer creates an Analyzer and registers its event handlers on the parser.
+// Only grenades thrown after this call are taken into account.
+func NewAnalyzer(parser demoinfocs.Parser) *Analyzer {
+	a := &Analyzer{
+		parser:       parser,
+		throwsByID:   make(map[int64]*Throw),
+		activeThrows: make(map[int]*Throw),
+		lastHE:       make(map[*common.Player]*Throw),
+	}
+
+	parser.RegisterEventHandler(a.grenadeProjectileThrow)
+	parser.RegisterEventHandler(a.grenadeProjectileDestroy)
+	parser.RegisterEventHandler(a.heExplode)
+	parser.RegisterEventHandler(a.playerFlashed)
+	parser.RegisterEventHandler(a.playerHurt)
+	parser.RegisterEventHandler(a.infernoExpired)
+	parser.RegisterEventHandler(a.infernoFireExtinguishedBySmoke)
+	parser.RegisterEventHandler(a.kill)
+
+	return a
+}
+
+// Throws returns all grenade throws so far, in chronological order.
+func (a *Analyzer) Throws() []*Throw {
+	return a.throws
+}
+
+// Throw returns the throw of the projectile with the given UniqueID() or nil if it's unknown.
+func (a *Analyzer) Throw(projectileID int64) *Throw {
+	return a.throwsByID[projectileID]
+}
+
+// ThrowsBy returns all throws of the given player, in chronological order.
+func (a *Analyzer) ThrowsBy(pl *common.Player) []*Throw {
+	var res []*Throw
+
+	for _, t := range a.throws {
+		if t.Thrower == pl {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
+
+// UnusedUtility returns the grenades players had when they died, in chronological order.
+// Deaths without any grenades are not included.
+func (a *Analyzer) UnusedUtility() []UnusedUtility {
+	return a.unusedUtility
+}
+
+func (a *Analyzer) roundNumber() int {
+	if round := a.parser.GameState().CurrentRound(); round != nil {
+		return round.Number
+	}
+
+	return 0
+}
+
+func (a *Analyzer) throwOf(proj *common.GrenadeProjectile) *Throw {
+	if proj == nil {
+		return nil
+	}
+
+	return a.throwsByID[proj.UniqueID()]
+}
+
+func (a *Analyzer) grenadeProjectileThrow(e events.GrenadeProjectileThrow) {
+	if e.Projectile == nil || a.throwOf(e.Projectile) != nil {
+		return
+	}
+
+	t := newThrow(e.Projectile, a.roundNumber(), a.parser.GameState().IngameTick())
+
+	a.throws = append(a.throws, t)
+	a.throwsByID[t.ProjectileID] = t
+
+	if e.Projectile.Entity != nil {
+		a.activeThrows[e.Projectile.Entity.ID()] = t
+	}
+}
+
+func (a *Analyzer) grenadeProjectileDestroy(e events.GrenadeProjectileDestroy) {
+	t := a.throwOf(e.Projectile)
+	if t == nil {
+		return
+	}
+
+	t.DestroyTick = a.parser.GameState().IngameTick()
+
+	// the smoke may outlast the projectile, the entity-ID is only reused by another projectile after it expired
+	if t.Type != common.EqSmoke && e.Projectile.Entity != nil && a.activeThrows[e.Projectile.Entity.ID()] == t {
+		delete(a.activeThrows, e.Projectile.Entity.ID())
+	}
+
+	// HeExplode may not be dispatched for all demos
+	if t.Type == common.EqHE && t.Thrower != nil {
+		a.lastHE[t.Thrower] = t
+	}
+}
+
+func (a *Analyzer) heExplode(e events.HeExplode) {
+	t := a.throwOf(e.Projectile)
+	if t == nil {
+		t = a.activeThrows[e.GrenadeEntityID]
+	}
+
+	if t == nil || t.Thrower == nil {
+		return
+	}
+
+	a.lastHE[t.Thrower] = t
+}
+
+func (a *Analyzer) playerFlashed(e events.PlayerFlashed) {
+	t := a.throwOf(e.Projectile)
+	if t == nil || e.Player == nil {
+		return
+	}
+
+	duration := time.Duration(float64(e.Duration) * float64(time.Second))
+
+	switch {
+	case e.Player == t.Thrower:
+		t.SelfFlashed = true
+	case t.Thrower != nil && e.Player.Team == t.Thrower.Team:
+		t.TeammatesFlashed++
+		t.TeammateBlindTime += duration
+	default:
+		t.EnemiesFlashed++
+		t.EnemyBlindTime += duration
+	}
+}
+
+func (a *Analyzer) playerHurt(e events.PlayerHurt) {
+	if e.Player == nil || e.Attacker == nil || e.Weapon == nil {
+		return
+	}
+
+	var t *Throw
+
+	switch e.Weapon.Type {
+	case common.EqHE:
+		t = a.lastHE[e.Attacker]
+	case common.EqMolotov, common.EqIncendiary:
+		t = a.infernoThrow(e.Attacker, e.Player)
+	}
+
+	if t == nil || e.Player == e.Attacker {
+		return
+	}
+
+	if e.Player.Team == e.Attacker.Team {
+		t.TeammateDamage += e.HealthDamage
+	} else {
+		t.EnemyDamage += e.HealthDamage
+		t.EnemyHits++
+	}
+}
+
+// infernoThrow returns the throw of the attacker's burning inferno that contains the victim.
+// Falls back to the attacker's latest inferno if none contains the victim.
+func (a *Analyzer) infernoThrow(attacker, victim *common.Player) *Throw {
+	var (
+		fallback *Throw
+		pos      = victim.Position()
+	)
+
+	for _, inf := range a.parser.GameState().Infernos() {
+		if inf.Thrower() != attacker || inf.Projectile == nil {
+			continue
+		}
+
+		t := a.throwOf(inf.Projectile)
+		if t == nil {
+			continue
+		}
+
+		if inf.Contains(pos) {
+			return t
+		}
+
+		if fallback == nil || t.ThrowTick > fallback.ThrowTick || (t.ThrowTick == fallback.ThrowTick && t.ProjectileID > fallback.ProjectileID) {
+			fallback = t
+		}
+	}
+
+	return fallback
+}
+
+func (a *Analyzer) infernoExpired(e events.InfernoExpired) {
+	if e.Inferno == nil {
+		return
+	}
+
+	t := a.throwOf(e.Inferno.Projectile)
+	if t == nil {
+		return
+	}
+
+	t.BurnDuration = e.Inferno.BurnDuration()
+	t.BurnArea = e.Inferno.GetFires().Area2D()
+	t.ExtinguishedBySmoke = t.ExtinguishedBySmoke || e.Inferno.ExtinguishedBySmoke
+}
+
+func (a *Analyzer) infernoFireExtinguishedBySmoke(e events.InfernoFireExtinguishedBySmoke) {
+	if t := a.throwOf(e.Inferno.Projectile); t != nil {
+		t.ExtinguishedBySmoke = true
+	}
+}
+
+func (a *Analyzer) kill(e events.Kill) {
+	if e.Victim == nil {
+		return
+	}
+
+	gs := a.parser.GameState()
+
+	if uu := newUnusedUtility(e.Victim, a.roundNumber(), gs.IngameTick()); len(uu.Grenades) > 0 {
+		a.unusedUtility = append(a.unusedUtility, uu)
+	}
+
+	if e.Killer == nil || e.Killer == e.Victim {
+		return
+	}
+
+	from, to := e.Killer.PositionEyes(), e.Victim.PositionEyes()
+
+	for entityID, smk := range gs.Smokes() {
+		if !smk.IntersectsLine(from, to) {
+			continue
+		}
+
+		if t := a.activeThrows[entityID]; t != nil {
+			t.BlockedKills++
+		}
+	}
+}
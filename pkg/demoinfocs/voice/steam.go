@@ -0,0 +1,223 @@
+package voice
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/pkg/errors"
+)
+
+// Payload types of the Steam voice format.
+// See https://github.com/ValveSoftware/source-sdk-2013 (voice_codec) and SteamUser.DecompressVoice.
+const (
+	steamPayloadSilence    = 0
+	steamPayloadOpusPLC    = 6
+	steamPayloadUnknown    = 10
+	steamPayloadSampleRate = 11
+)
+
+const (
+	steamIDSize  = 8
+	checksumSize = 4
+
+	// opusFrameReset is used as frame length to signal that the decoder state should be reset.
+	opusFrameReset = 0xFFFF
+)
+
+// Errors returned when parsing voice payloads.
+var (
+	// ErrPacketTooShort signals that a Steam voice packet doesn't contain the minimum required header and checksum.
+	ErrPacketTooShort = errors.New("voice packet is too short")
+
+	// ErrChecksumMismatch signals that the CRC32 checksum of a Steam voice packet is invalid.
+	ErrChecksumMismatch = errors.New("voice packet checksum mismatch")
+
+	// ErrUnknownPayloadType signals that a Steam voice packet contains an unsupported payload type.
+	ErrUnknownPayloadType = errors.New("unknown voice payload type")
+)
+
+// SteamPacket is a parsed Steam voice packet (VOICEDATA_FORMAT_STEAM).
+type SteamPacket struct {
+	SteamID64  uint64
+	SampleRate int
+	Segments   []Segment
+}
+
+// Segment is a single piece of a voice stream.
+// Either an encoded frame, a number of silent samples or a decoder reset.
+type Segment struct {
+	Data     []byte // A single encoded Opus frame, nil for silence & resets
+	Silence  int    // Number of silent samples to insert
+	Reset    bool   // True if the decoder state should be reset before decoding the next frame
+	Sequence int    // Frame sequence number, only available for the Steam format
+}
+
+// ParseSteamPacket parses a voice payload in the Steam format (VOICEDATA_FORMAT_STEAM).
+//
+// Returns ErrPacketTooShort, ErrChecksumMismatch or ErrUnknownPayloadType for malformed packets.
+func ParseSteamPacket(data []byte) (*SteamPacket, error) {
+	if len(data) < steamIDSize+checksumSize {
+		return nil, ErrPacketTooShort
+	}
+
+	end := len(data) - checksumSize
+	if crc32.ChecksumIEEE(data[:end]) != binary.LittleEndian.Uint32(data[end:]) {
+		return nil, ErrChecksumMismatch
+	}
+
+	packet := &SteamPacket{
+		SteamID64: binary.LittleEndian.Uint64(data),
+	}
+
+	r := payloadReader{data: data[:end], pos: steamIDSize}
+
+	for r.remaining() > 0 {
+		payloadType := r.byte()
+
+		switch payloadType {
+		case steamPayloadSampleRate:
+			packet.SampleRate = int(r.uint16())
+
+		case steamPayloadUnknown:
+			r.uint16()
+
+		case steamPayloadSilence:
+			packet.Segments = append(packet.Segments, Segment{Silence: int(r.uint16())})
+
+		case steamPayloadOpusPLC:
+			segments, err := parseOpusPLC(r.bytes(int(r.uint16())))
+			if err != nil {
+				return nil, err
+			}
+
+			packet.Segments = append(packet.Segments, segments...)
+
+		default:
+			return nil, errors.Wrapf(ErrUnknownPayloadType, "payload type %d", payloadType)
+		}
+
+		if r.err {
+			return nil, ErrPacketTooShort
+		}
+	}
+
+	return packet, nil
+}
+
+// parseOpusPLC splits an Opus PLC payload into its frames.
+// Each frame is prefixed with its length and a sequence number (both uint16).
+func parseOpusPLC(data []byte) ([]Segment, error) {
+	var segments []Segment
+
+	r := payloadReader{data: data}
+
+	for r.remaining() > 0 {
+		frameLen := int(r.uint16())
+		if frameLen == opusFrameReset {
+			segments = append(segments, Segment{Reset: true})
+
+			continue
+		}
+
+		seq := int(r.uint16())
+		frame := r.bytes(frameLen)
+
+		if r.err {
+			return nil, ErrPacketTooShort
+		}
+
+		segments = append(segments, Segment{
+			Data:     frame,
+			Sequence: seq,
+		})
+	}
+
+	return segments, nil
+}
+
+// SplitOpusPackets splits a voice payload in the Opus format (VOICEDATA_FORMAT_OPUS) into its individual frames.
+// packetOffsets are the offsets as sent in CMsgVoiceAudio.packet_offsets.
+func SplitOpusPackets(data []byte, packetOffsets []uint32) []Segment {
+	if len(packetOffsets) <= 1 {
+		return []Segment{{Data: data}}
+	}
+
+	offsets := make([]int, 0, len(packetOffsets)+1)
+
+	// offsets may either be start offsets (first one being 0) or end offsets
+	if packetOffsets[0] != 0 {
+		offsets = append(offsets, 0)
+	}
+
+	for _, off := range packetOffsets {
+		offsets = append(offsets, min(int(off), len(data)))
+	}
+
+	if offsets[len(offsets)-1] != len(data) {
+		offsets = append(offsets, len(data))
+	}
+
+	segments := make([]Segment, 0, len(offsets)-1)
+
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] <= offsets[i-1] {
+			continue
+		}
+
+		segments = append(segments, Segment{Data: data[offsets[i-1]:offsets[i]]})
+	}
+
+	return segments
+}
+
+type payloadReader struct {
+	data []byte
+	pos  int
+	err  bool
+}
+
+func (r *payloadReader) remaining() int {
+	return len(r.data) - r.pos
+}
+
+func (r *payloadReader) byte() byte {
+	if r.remaining() < 1 {
+		r.err = true
+		r.pos = len(r.data)
+
+		return 0
+	}
+
+	b := r.data[r.pos]
+	r.pos++
+
+	return b
+}
+
+func (r *payloadReader) uint16() uint16 {
+	if r.remaining() < 2 {
+		r.err = true
+		r.pos = len(r.data)
+
+		return 0
+	}
+
+	v := binary.LittleEndian.Uint16(r.data[r.pos:])
+	r.pos += 2
+
+	return v
+}
+
+func (r *payloadReader) bytes(n int) []byte {
+	if r.remaining() < n {
+		r.err = true
+		r.pos = len(r.data)
+
+		return nil
+	}
+
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+
+	return b
+}
@@ -0,0 +1,287 @@
+// Package voice provides helpers for reassembling in-game voice communication from demos into per-speaker Opus streams.
+//
+// Voice data is dispatched by the parser as events.VoiceData.
+// A Recorder collects these events into one Stream per speaker.
+// Converting a Stream to PCM / WAV requires a caller-supplied Opus decoder.
+//
+// This package does NOT ship an Opus decoder: Stream.PCM() and Stream.WriteWAV() require a DecoderFactory,
+// without one nothing can be decoded (ErrNoDecoder). Decoding Opus frames is delegated to a Decoder
+// (e.g. a small wrapper around gopkg.in/hraban/opus.v2, see the example below)
+// so this package doesn't force a cgo dependency onto users of the parser.
+// Reassembling the streams (Recorder, Stream.Frames) works without a decoder.
+//
+// Example:
+//
+//	rec := voice.NewRecorder(parser)
+//	parser.ParseToEnd()
+//
+//	for _, s := range rec.Streams() {
+//		f, _ := os.Create(fmt.Sprintf("%d.wav", s.SteamID64))
+//		s.WriteWAV(f, newOpusDecoder, parser.TickRate())
+//		f.Close()
+//	}
+//
+// Where newOpusDecoder wraps an Opus library of your choice, e.g. gopkg.in/hraban/opus.v2:
+//
+//	type opusDecoder struct {
+//		dec *opus.Decoder
+//		buf []int16
+//	}
+//
+//	func (d *opusDecoder) Decode(frame []byte) ([]int16, error) {
+//		n, err := d.dec.Decode(frame, d.buf)
+//		return d.buf[:n], err
+//	}
+//
+//	func newOpusDecoder(sampleRate int) (voice.Decoder, error) {
+//		dec, err := opus.NewDecoder(sampleRate, 1)
+//		return &opusDecoder{dec: dec, buf: make([]int16, sampleRate/10)}, err
+//	}
+package voice
+
+import (
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/events"
+	"github.com/markus-wa/demoinfocs-golang/v4/pkg/demoinfocs/msgs2"
+)
+
+// Default sample rates used if none is networked.
+const (
+	DefaultSampleRateSteam = 24000
+	DefaultSampleRateOpus  = 48000
+)
+
+// ErrUnsupportedFormat signals that voice data in an unsupported format (e.g. VOICEDATA_FORMAT_ENGINE) was received.
+var ErrUnsupportedFormat = errors.New("unsupported voice data format")
+
+// ErrNoDecoder signals that a stream was decoded without a DecoderFactory, this package doesn't include an Opus decoder.
+var ErrNoDecoder = errors.New("no voice decoder given, an Opus decoder must be supplied via DecoderFactory")
+
+// Decoder decodes single encoded Opus frames to mono 16-bit PCM samples.
+// Decoders are stateful, a new one is created for every Stream.
+type Decoder interface {
+	Decode(frame []byte) ([]int16, error)
+}
+
+// DecoderFactory creates a new Decoder for the given sample rate.
+type DecoderFactory func(sampleRate int) (Decoder, error)
+
+// Frame is a Segment of a voice stream with the tick at which it was received.
+type Frame struct {
+	Segment
+	Tick int
+}
+
+// Stream contains all voice frames of a single speaker in the order they were received.
+type Stream struct {
+	SteamID64  uint64
+	Client     int            // Client slot of the speaker (entity-ID - 1)
+	Player     *common.Player // Last known player instance of the speaker, may be nil
+	Format     msgs2.VoiceDataFormatT
+	SampleRate int
+	Frames     []Frame
+}
+
+// StartTick returns the tick of the first frame of the stream or -1 if it's empty.
+func (s *Stream) StartTick() int {
+	if len(s.Frames) == 0 {
+		return -1
+	}
+
+	return s.Frames[0].Tick
+}
+
+// EndTick returns the tick of the last frame of the stream or -1 if it's empty.
+func (s *Stream) EndTick() int {
+	if len(s.Frames) == 0 {
+		return -1
+	}
+
+	return s.Frames[len(s.Frames)-1].Tick
+}
+
+// PCM decodes the stream to mono 16-bit PCM samples using decoders created by newDecoder.
+// Returns ErrNoDecoder if newDecoder is nil.
+//
+// The result is aligned with the ticks at which the frames were received:
+// sample 0 corresponds to StartTick() and gaps between transmissions are filled with silence.
+// Pass tickRate <= 0 to concatenate the frames without alignment.
+func (s *Stream) PCM(newDecoder DecoderFactory, tickRate float64) ([]int16, error) {
+	if newDecoder == nil {
+		return nil, ErrNoDecoder
+	}
+
+	if len(s.Frames) == 0 {
+		return nil, nil
+	}
+
+	dec, err := newDecoder(s.SampleRate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create decoder")
+	}
+
+	startTick := s.StartTick()
+
+	var pcm []int16
+
+	for _, f := range s.Frames {
+		if tickRate > 0 {
+			offset := int(math.Round(float64(f.Tick-startTick) / tickRate * float64(s.SampleRate)))
+			if offset > len(pcm) {
+				pcm = append(pcm, make([]int16, offset-len(pcm))...)
+			}
+		}
+
+		switch {
+		case f.Reset:
+			dec, err = newDecoder(s.SampleRate)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to reset decoder")
+			}
+
+		case f.Silence > 0:
+			pcm = append(pcm, make([]int16, f.Silence)...)
+
+		case len(f.Data) > 0:
+			samples, err := dec.Decode(f.Data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode frame at tick %d", f.Tick)
+			}
+
+			pcm = append(pcm, samples...)
+		}
+	}
+
+	return pcm, nil
+}
+
+// WriteWAV decodes the stream (see PCM()) and writes it as a WAV file to w.
+func (s *Stream) WriteWAV(w io.Writer, newDecoder DecoderFactory, tickRate float64) error {
+	pcm, err := s.PCM(newDecoder, tickRate)
+	if err != nil {
+		return err
+	}
+
+	return WriteWAV(w, pcm, s.SampleRate)
+}
+
+type streamKey struct {
+	steamID64 uint64
+	client    int
+}
+
+// Recorder collects events.VoiceData into one Stream per speaker.
+type Recorder struct {
+	streams map[streamKey]*Stream
+	order   []*Stream
+	err     error
+}
+
+// NewRecorder creates a Recorder and registers it on the parser.
+// All voice data dispatched after this call is recorded.
+func NewRecorder(parser demoinfocs.Parser) *Recorder {
+	rec := &Recorder{
+		streams: make(map[streamKey]*Stream),
+	}
+
+	parser.RegisterEventHandler(func(e events.VoiceData) {
+		err := rec.Add(e)
+		if err != nil && rec.err == nil {
+			rec.err = err
+		}
+	})
+
+	return rec
+}
+
+// Add adds the data of a single VoiceData event to the stream of its speaker.
+// Usually not needed as NewRecorder() already registers an event handler.
+//
+// Returns ErrUnsupportedFormat or a parsing error (see ParseSteamPacket()) for unusable data.
+func (r *Recorder) Add(e events.VoiceData) error {
+	var (
+		segments   []Segment
+		sampleRate = e.SampleRate
+	)
+
+	switch e.Format {
+	case msgs2.VoiceDataFormatT_VOICEDATA_FORMAT_OPUS:
+		segments = SplitOpusPackets(e.Data, e.PacketOffsets)
+
+		if sampleRate == 0 {
+			sampleRate = DefaultSampleRateOpus
+		}
+
+	case msgs2.VoiceDataFormatT_VOICEDATA_FORMAT_STEAM:
+		packet, err := ParseSteamPacket(e.Data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse voice data at tick %d", e.Tick)
+		}
+
+		segments = packet.Segments
+
+		if sampleRate == 0 {
+			sampleRate = packet.SampleRate
+		}
+
+		if sampleRate == 0 {
+			sampleRate = DefaultSampleRateSteam
+		}
+
+	default:
+		return errors.Wrapf(ErrUnsupportedFormat, "format %s", e.Format)
+	}
+
+	s := r.stream(e)
+	s.Format = e.Format
+	s.SampleRate = sampleRate
+
+	if e.Player != nil {
+		s.Player = e.Player
+	}
+
+	for _, seg := range segments {
+		s.Frames = append(s.Frames, Frame{
+			Segment: seg,
+			Tick:    e.Tick,
+		})
+	}
+
+	return nil
+}
+
+func (r *Recorder) stream(e events.VoiceData) *Stream {
+	key := streamKey{steamID64: e.SteamID64}
+	if e.SteamID64 == 0 {
+		key.client = e.Client
+	}
+
+	s, ok := r.streams[key]
+	if !ok {
+		s = &Stream{
+			SteamID64: e.SteamID64,
+			Client:    e.Client,
+		}
+		r.streams[key] = s
+		r.order = append(r.order, s)
+	}
+
+	return s
+}
+
+// Streams returns all recorded streams, ordered by the time the speaker first talked.
+func (r *Recorder) Streams() []*Stream {
+	return r.order
+}
+
+// Err returns the first error that occurred while recording, if any.
+// Unusable voice data is skipped, so the recorded streams may still be useful.
+func (r *Recorder) Err() error {
+	return r.err
+}
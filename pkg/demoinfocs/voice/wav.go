@@ -0,0 +1,52 @@
+package voice
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	wavHeaderSize    = 44
+	wavBitsPerSample = 16
+	wavChannels      = 1
+	wavFormatPCM     = 1
+)
+
+// WriteWAV writes mono 16-bit PCM samples as a WAV file to w.
+func WriteWAV(w io.Writer, pcm []int16, sampleRate int) error {
+	const bytesPerSample = wavBitsPerSample / 8
+
+	dataSize := len(pcm) * bytesPerSample
+	header := make([]byte, wavHeaderSize)
+
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(wavHeaderSize-8+dataSize))
+	copy(header[8:], "WAVE")
+	copy(header[12:], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:], 16) // size of the fmt chunk
+	binary.LittleEndian.PutUint16(header[20:], wavFormatPCM)
+	binary.LittleEndian.PutUint16(header[22:], wavChannels)
+	binary.LittleEndian.PutUint32(header[24:], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(header[28:], uint32(sampleRate*wavChannels*bytesPerSample))
+	binary.LittleEndian.PutUint16(header[32:], wavChannels*bytesPerSample)
+	binary.LittleEndian.PutUint16(header[34:], wavBitsPerSample)
+	copy(header[36:], "data")
+	binary.LittleEndian.PutUint32(header[40:], uint32(dataSize))
+
+	if _, err := w.Write(header); err != nil {
+		return errors.Wrap(err, "failed to write WAV header")
+	}
+
+	data := make([]byte, dataSize)
+	for i, sample := range pcm {
+		binary.LittleEndian.PutUint16(data[i*bytesPerSample:], uint16(sample))
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write WAV data")
+	}
+
+	return nil
+}